@@ -0,0 +1,296 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// AggResults holds the results of the aggregations of a search response (or of
+// the sub-aggregations of a bucket), keyed by aggregation name. Results are
+// kept in their raw form and decoded on access, via the accessor matching the
+// type of the aggregation, e.g.:
+//
+//	aggs.Terms("brands").Buckets[0].Key
+//	*aggs.Avg("avg_price").Value
+//
+// Accessors return nil if no aggregation exists under the provided name, or if
+// its result does not have the shape of the requested type: metric results
+// must have a "value", bucket results a "doc_count", and multi-bucket results
+// "buckets". Use Decode to get the error instead, or to decode into custom
+// types.
+type AggResults map[string]json.RawMessage
+
+// BucketsResult is the result of a multi-bucket aggregation, such as "terms",
+// "histogram", "date_histogram", "range" or "composite".
+type BucketsResult struct {
+	// Buckets is the list of buckets of the aggregation. Keyed buckets (e.g.
+	// those of a "filters" aggregation) are sorted by key.
+	Buckets []Bucket
+
+	// DocCountErrorUpperBound is the maximum number of documents a term may
+	// be missing from its count ("terms" aggregations only).
+	DocCountErrorUpperBound int64
+
+	// SumOtherDocCount is the number of documents not part of any returned
+	// bucket ("terms" aggregations only).
+	SumOtherDocCount int64
+
+	// AfterKey is the key to provide in order to get the next page of buckets
+	// ("composite" aggregations only). Numbers are decoded as json.Number.
+	AfterKey map[string]interface{}
+}
+
+// Bucket is a single bucket of a bucket aggregation. It is also the result of
+// single-bucket aggregations, such as "filter", "global", "missing" and
+// "nested".
+type Bucket struct {
+	// Key is the key of the bucket. Its type depends on the aggregation, e.g.
+	// a string for terms of keyword fields, or a json.Number for terms of
+	// numeric fields and for histograms, so that long keys (such as IDs) keep
+	// their precision. Composite aggregations have map keys.
+	Key interface{}
+
+	// KeyAsString is the formatted key of the bucket, if any.
+	KeyAsString string
+
+	// DocCount is the number of documents in the bucket.
+	DocCount int64
+
+	// Aggs holds the results of the sub-aggregations of the bucket.
+	Aggs AggResults
+}
+
+// MetricResult is the result of a single-value metric aggregation, such as
+// "avg", "sum", "min", "max", "cardinality" or "value_count", and of most
+// pipeline aggregations.
+type MetricResult struct {
+	// Value is the value of the metric. It is nil if ElasticSearch returned
+	// null, e.g. for the average of an empty set of documents, which tells "no
+	// data" apart from an actual zero.
+	Value *float64 `json:"value"`
+
+	// ValueAsString is the formatted value of the metric, if any.
+	ValueAsString string `json:"value_as_string"`
+}
+
+// DecodeAggregations decodes the aggregation results of a search response. It
+// receives the return values of a Run method directly, like CheckResponse, e.g.
+//
+//	aggs, err := elasticsearch.DecodeAggregations(req.Run(es))
+//
+// The response body is closed by DecodeAggregations. Unsuccessful responses
+// are returned as an *ESError.
+func DecodeAggregations(res *esapi.Response, err error) (AggResults, error) {
+	res, err = CheckResponse(res, err)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var body struct {
+		Aggregations AggResults `json:"aggregations"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding search response: %w", err)
+	}
+
+	return body.Aggregations, nil
+}
+
+// Decode decodes the result of the aggregation with the provided name into v.
+func (aggs AggResults) Decode(name string, v interface{}) error {
+	raw, ok := aggs[name]
+	if !ok {
+		return fmt.Errorf("no aggregation named %q in results", name)
+	}
+
+	return json.Unmarshal(raw, v)
+}
+
+// Buckets returns the result of the multi-bucket aggregation with the provided
+// name.
+func (aggs AggResults) Buckets(name string) *BucketsResult {
+	var result BucketsResult
+	if aggs.Decode(name, &result) != nil {
+		return nil
+	}
+
+	return &result
+}
+
+// Terms returns the result of the "terms" aggregation with the provided name.
+// It is the same as Buckets.
+func (aggs AggResults) Terms(name string) *BucketsResult {
+	return aggs.Buckets(name)
+}
+
+// Bucket returns the result of the single-bucket aggregation with the provided
+// name.
+func (aggs AggResults) Bucket(name string) *Bucket {
+	var result Bucket
+	if aggs.Decode(name, &result) != nil {
+		return nil
+	}
+
+	return &result
+}
+
+// Metric returns the result of the single-value metric aggregation with the
+// provided name.
+func (aggs AggResults) Metric(name string) *MetricResult {
+	var result MetricResult
+	if aggs.Decode(name, &result) != nil {
+		return nil
+	}
+
+	return &result
+}
+
+// Avg returns the result of the "avg" aggregation with the provided name. It
+// is the same as Metric.
+func (aggs AggResults) Avg(name string) *MetricResult {
+	return aggs.Metric(name)
+}
+
+// Sum returns the result of the "sum" aggregation with the provided name. It
+// is the same as Metric.
+func (aggs AggResults) Sum(name string) *MetricResult {
+	return aggs.Metric(name)
+}
+
+// Min returns the result of the "min" aggregation with the provided name. It
+// is the same as Metric.
+func (aggs AggResults) Min(name string) *MetricResult {
+	return aggs.Metric(name)
+}
+
+// Max returns the result of the "max" aggregation with the provided name. It
+// is the same as Metric.
+func (aggs AggResults) Max(name string) *MetricResult {
+	return aggs.Metric(name)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It fails if the
+// result has no "value", i.e. if it is not the result of a single-value metric
+// aggregation.
+func (result *MetricResult) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+	if _, ok := fields["value"]; !ok {
+		return fmt.Errorf("aggregation result has no value")
+	}
+
+	type plain MetricResult
+	return json.Unmarshal(b, (*plain)(result))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Buckets may either
+// be an array, or an object keyed by bucket key.
+func (result *BucketsResult) UnmarshalJSON(b []byte) error {
+	var body struct {
+		Buckets                 json.RawMessage `json:"buckets"`
+		DocCountErrorUpperBound int64           `json:"doc_count_error_upper_bound"`
+		SumOtherDocCount        int64           `json:"sum_other_doc_count"`
+		AfterKey                json.RawMessage `json:"after_key"`
+	}
+	err := json.Unmarshal(b, &body)
+	if err != nil {
+		return err
+	}
+	if len(body.Buckets) == 0 {
+		return fmt.Errorf("aggregation result has no buckets")
+	}
+
+	result.DocCountErrorUpperBound = body.DocCountErrorUpperBound
+	result.SumOtherDocCount = body.SumOtherDocCount
+	if len(body.AfterKey) > 0 {
+		err = decodeNumbers(body.AfterKey, &result.AfterKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	if body.Buckets[0] == '[' {
+		return json.Unmarshal(body.Buckets, &result.Buckets)
+	}
+
+	var keyed map[string]Bucket
+	err = json.Unmarshal(body.Buckets, &keyed)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(keyed))
+	for key := range keyed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result.Buckets = make([]Bucket, len(keys))
+	for i, key := range keys {
+		bucket := keyed[key]
+		if bucket.Key == nil {
+			bucket.Key = key
+		}
+		result.Buckets[i] = bucket
+	}
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. All object fields
+// of the bucket other than its key are considered sub-aggregation results.
+func (bucket *Bucket) UnmarshalJSON(b []byte) error {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+
+	docCount, ok := fields["doc_count"]
+	if !ok {
+		return fmt.Errorf("aggregation result has no doc_count")
+	}
+	err = json.Unmarshal(docCount, &bucket.DocCount)
+	if err != nil {
+		return err
+	}
+	if key, ok := fields["key"]; ok {
+		err = decodeNumbers(key, &bucket.Key)
+		if err != nil {
+			return err
+		}
+	}
+	if key, ok := fields["key_as_string"]; ok {
+		err = json.Unmarshal(key, &bucket.KeyAsString)
+		if err != nil {
+			return err
+		}
+	}
+
+	bucket.Aggs = make(AggResults)
+	for name, raw := range fields {
+		if name != "key" && len(raw) > 0 && raw[0] == '{' {
+			bucket.Aggs[name] = raw
+		}
+	}
+
+	return nil
+}
+
+// decodeNumbers is the same as json.Unmarshal, except that numbers stored in
+// interface{} values are decoded as json.Number rather than float64.
+func decodeNumbers(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
@@ -0,0 +1,100 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestAggResults(t *testing.T) {
+	search := fakeSearch(200, `{
+		"hits": {"total": {"value": 10, "relation": "eq"}, "max_score": null, "hits": []},
+		"aggregations": {
+			"brands": {
+				"doc_count_error_upper_bound": 0,
+				"sum_other_doc_count": 4,
+				"buckets": [
+					{"key": "acme", "doc_count": 4, "avg_price": {"value": 12.5}},
+					{"key": "globex", "doc_count": 2, "avg_price": {"value": null}}
+				]
+			},
+			"avg_price": {"value": 10.25},
+			"min_price": {"value": 0},
+			"by_status": {
+				"buckets": {
+					"open": {"doc_count": 3},
+					"closed": {"doc_count": 7}
+				}
+			},
+			"all": {"doc_count": 10, "max_price": {"value": 30}},
+			"user_ids": {
+				"after_key": {"user_id": 9007199254740993},
+				"buckets": [{"key": 9007199254740993, "doc_count": 1}]
+			},
+			"per_day": {
+				"buckets": [
+					{"key_as_string": "2020-01-01", "key": 1577836800000, "doc_count": 5}
+				]
+			}
+		}
+	}`, nil)
+
+	res, err := RunSearchTyped[testDoc](Search().Size(0), search)
+	assert.Nil(t, err)
+
+	aggs := res.Aggregations
+	brands := aggs.Terms("brands")
+	assert.NotNil(t, brands)
+	assert.Equal(t, int64(4), brands.SumOtherDocCount)
+	assert.Equal(t, 2, len(brands.Buckets))
+	assert.Equal(t, "acme", brands.Buckets[0].Key)
+	assert.Equal(t, int64(4), brands.Buckets[0].DocCount)
+	assert.Equal(t, 12.5, *brands.Buckets[0].Aggs.Avg("avg_price").Value)
+	assert.True(t, brands.Buckets[1].Aggs.Avg("avg_price").Value == nil)
+
+	assert.Equal(t, 10.25, *aggs.Avg("avg_price").Value)
+	assert.True(t, aggs.Min("min_price").Value != nil)
+	assert.Equal(t, 0.0, *aggs.Min("min_price").Value)
+
+	byStatus := aggs.Buckets("by_status")
+	assert.NotNil(t, byStatus)
+	assert.Equal(t, 2, len(byStatus.Buckets))
+	assert.Equal(t, "closed", byStatus.Buckets[0].Key)
+	assert.Equal(t, int64(7), byStatus.Buckets[0].DocCount)
+
+	all := aggs.Bucket("all")
+	assert.NotNil(t, all)
+	assert.Equal(t, int64(10), all.DocCount)
+	assert.Equal(t, 30.0, *all.Aggs.Max("max_price").Value)
+
+	perDay := aggs.Buckets("per_day")
+	assert.Equal(t, "2020-01-01", perDay.Buckets[0].KeyAsString)
+	assert.Equal(t, json.Number("1577836800000"), perDay.Buckets[0].Key)
+
+	userIDs := aggs.Terms("user_ids")
+	assert.Equal(t, json.Number("9007199254740993"), userIDs.Buckets[0].Key)
+	assert.DeepEqual(t, map[string]interface{}{"user_id": json.Number("9007199254740993")}, userIDs.AfterKey)
+
+	assert.True(t, aggs.Terms("missing") == nil)
+	assert.True(t, aggs.Buckets("avg_price") == nil)
+	assert.True(t, aggs.Bucket("brands") == nil)
+	assert.True(t, aggs.Bucket("avg_price") == nil)
+	assert.True(t, aggs.Avg("brands") == nil)
+	assert.True(t, aggs.Metric("all") == nil)
+	assert.NotNil(t, aggs.Decode("brands", &MetricResult{}))
+	assert.NotNil(t, aggs.Decode("missing", &struct{}{}))
+}
+
+func TestDecodeAggregations(t *testing.T) {
+	search := fakeSearch(200, `{"aggregations": {"total_likes": {"value": 42}}}`, nil)
+
+	aggs, err := DecodeAggregations(Search().Aggs(Sum("total_likes", "likes")).RunSearch(search))
+	assert.Nil(t, err)
+	assert.Equal(t, 42.0, *aggs.Sum("total_likes").Value)
+
+	search = fakeSearch(500, `{"error": {"type": "exception", "reason": "boom"}, "status": 500}`, nil)
+	_, err = DecodeAggregations(Search().RunSearch(search))
+	_, ok := err.(*ESError)
+	assert.True(t, ok)
+}
@@ -156,5 +156,42 @@ func TestAggregations(t *testing.T) {
 				},
 			},
 		},
+		{
+			"a bucket aggregation with nested metrics, alongside a sibling",
+			Aggregate(
+				TermsAgg("genres", "genre").
+					Aggs(
+						Avg("avg_price", "price"),
+						Max("max_price", "price"),
+					),
+				Cardinality("artists", "artist"),
+			),
+			map[string]interface{}{
+				"aggs": map[string]interface{}{
+					"genres": map[string]interface{}{
+						"terms": map[string]interface{}{
+							"field": "genre",
+						},
+						"aggs": map[string]interface{}{
+							"avg_price": map[string]interface{}{
+								"avg": map[string]interface{}{
+									"field": "price",
+								},
+							},
+							"max_price": map[string]interface{}{
+								"max": map[string]interface{}{
+									"field": "price",
+								},
+							},
+						},
+					},
+					"artists": map[string]interface{}{
+						"cardinality": map[string]interface{}{
+							"field": "artist",
+						},
+					},
+				},
+			},
+		},
 	})
 }
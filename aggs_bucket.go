@@ -14,7 +14,10 @@ type TermsAggregation struct {
 	showTermDoc *bool
 	aggs        []Aggregation
 	order       map[string]string
+	orderBy     []map[string]interface{}
 	include     []string
+	exclude     []string
+	minDocCount *uint64
 }
 
 // TermsAgg creates a new aggregation of type "terms". The method name includes
@@ -57,18 +60,43 @@ func (agg *TermsAggregation) Aggs(aggs ...Aggregation) *TermsAggregation {
 	return agg
 }
 
-// Order sets the sort for terms agg
+// Order sets the sort for terms agg. It is ignored if OrderBy is used.
 func (agg *TermsAggregation) Order(order map[string]string) *TermsAggregation {
 	agg.order = order
 	return agg
 }
 
+// OrderBy adds an ordering criterion for the buckets: "_count", "_key", or the
+// path of a sub-aggregation metric such as "avg_price" or "stats.max". It can
+// be called multiple times to break ties, in which case the criteria are sent
+// as an array, in the order provided.
+func (agg *TermsAggregation) OrderBy(key string, order Order) *TermsAggregation {
+	agg.orderBy = append(agg.orderBy, map[string]interface{}{
+		key: order,
+	})
+	return agg
+}
+
 // Include filter the values for  buckets
 func (agg *TermsAggregation) Include(include ...string) *TermsAggregation {
 	agg.include = include
 	return agg
 }
 
+// Exclude filters out the values for buckets. A single value is treated as a
+// regular expression, multiple values as an exact list of terms.
+func (agg *TermsAggregation) Exclude(exclude ...string) *TermsAggregation {
+	agg.exclude = exclude
+	return agg
+}
+
+// MinDocCount sets the minimum number of documents a term must match in order
+// to be returned as a bucket.
+func (agg *TermsAggregation) MinDocCount(count uint64) *TermsAggregation {
+	agg.minDocCount = &count
+	return agg
+}
+
 // Map returns a map representation of the aggregation, thus implementing the
 // Mappable interface.
 func (agg *TermsAggregation) Map() map[string]interface{} {
@@ -85,9 +113,16 @@ func (agg *TermsAggregation) Map() map[string]interface{} {
 	if agg.showTermDoc != nil {
 		innerMap["show_term_doc_count_error"] = *agg.showTermDoc
 	}
-	if agg.order != nil {
+	if len(agg.orderBy) == 1 {
+		innerMap["order"] = agg.orderBy[0]
+	} else if len(agg.orderBy) > 1 {
+		innerMap["order"] = agg.orderBy
+	} else if agg.order != nil {
 		innerMap["order"] = agg.order
 	}
+	if agg.minDocCount != nil {
+		innerMap["min_doc_count"] = *agg.minDocCount
+	}
 
 	if agg.include != nil {
 		if len(agg.include) <= 1 {
@@ -98,15 +133,627 @@ func (agg *TermsAggregation) Map() map[string]interface{} {
 
 	}
 
+	if len(agg.exclude) > 0 {
+		if len(agg.exclude) == 1 {
+			innerMap["exclude"] = agg.exclude[0]
+		} else {
+			innerMap["exclude"] = agg.exclude
+		}
+	}
+
 	outerMap := map[string]interface{}{
 		"terms": innerMap,
 	}
 	if len(agg.aggs) > 0 {
-		subAggs := make(map[string]map[string]interface{})
-		for _, sub := range agg.aggs {
-			subAggs[sub.Name()] = sub.Map()
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// DateHistogramAggregation represents an aggregation of type
+// "date_histogram", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-datehistogram-aggregation.html
+type DateHistogramAggregation struct {
+	name             string
+	field            string
+	calendarInterval string
+	fixedInterval    string
+	timeZone         string
+	format           string
+	minDocCount      *uint64
+	extendedBounds   map[string]interface{}
+	aggs             []Aggregation
+}
+
+// DateHistogram creates a new aggregation of type "date_histogram" with the
+// provided name and on the provided field.
+func DateHistogram(name, field string) *DateHistogramAggregation {
+	return &DateHistogramAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *DateHistogramAggregation) Name() string {
+	return agg.name
+}
+
+// CalendarInterval sets a calendar-aware interval for the buckets, e.g.
+// "1M" or "week". If both a calendar and a fixed interval are set, the
+// calendar interval is used.
+func (agg *DateHistogramAggregation) CalendarInterval(
+	interval string,
+) *DateHistogramAggregation {
+	agg.calendarInterval = interval
+	return agg
+}
+
+// FixedInterval sets a fixed interval for the buckets, e.g. "90m" or "30d".
+// It is ignored if a calendar interval is set as well.
+func (agg *DateHistogramAggregation) FixedInterval(
+	interval string,
+) *DateHistogramAggregation {
+	agg.fixedInterval = interval
+	return agg
+}
+
+// TimeZone sets the time zone used for bucketing and rounding.
+func (agg *DateHistogramAggregation) TimeZone(tz string) *DateHistogramAggregation {
+	agg.timeZone = tz
+	return agg
+}
+
+// Format sets the date format used for the keys of the buckets.
+func (agg *DateHistogramAggregation) Format(format string) *DateHistogramAggregation {
+	agg.format = format
+	return agg
+}
+
+// MinDocCount sets the minimum number of documents a bucket must contain in
+// order to be returned. Set it to zero to return empty buckets as well.
+func (agg *DateHistogramAggregation) MinDocCount(count uint64) *DateHistogramAggregation {
+	agg.minDocCount = &count
+	return agg
+}
+
+// ExtendedBounds forces the histogram to start building buckets at min and
+// stop at max, even when no documents exist in those ranges.
+func (agg *DateHistogramAggregation) ExtendedBounds(
+	min, max interface{},
+) *DateHistogramAggregation {
+	agg.extendedBounds = map[string]interface{}{
+		"min": min,
+		"max": max,
+	}
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *DateHistogramAggregation) Aggs(aggs ...Aggregation) *DateHistogramAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *DateHistogramAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field": agg.field,
+	}
+
+	if agg.calendarInterval != "" {
+		innerMap["calendar_interval"] = agg.calendarInterval
+	} else if agg.fixedInterval != "" {
+		innerMap["fixed_interval"] = agg.fixedInterval
+	}
+	if agg.timeZone != "" {
+		innerMap["time_zone"] = agg.timeZone
+	}
+	if agg.format != "" {
+		innerMap["format"] = agg.format
+	}
+	if agg.minDocCount != nil {
+		innerMap["min_doc_count"] = *agg.minDocCount
+	}
+	if agg.extendedBounds != nil {
+		innerMap["extended_bounds"] = agg.extendedBounds
+	}
+
+	outerMap := map[string]interface{}{
+		"date_histogram": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// HistogramAggregation represents an aggregation of type "histogram", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-histogram-aggregation.html
+type HistogramAggregation struct {
+	name           string
+	field          string
+	interval       float64
+	offset         float64
+	minDocCount    *uint64
+	extendedBounds map[string]interface{}
+	aggs           []Aggregation
+}
+
+// Histogram creates a new aggregation of type "histogram" with the provided
+// name and on the provided numeric field.
+func Histogram(name, field string) *HistogramAggregation {
+	return &HistogramAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *HistogramAggregation) Name() string {
+	return agg.name
+}
+
+// Interval sets the interval of the buckets.
+func (agg *HistogramAggregation) Interval(interval float64) *HistogramAggregation {
+	agg.interval = interval
+	return agg
+}
+
+// Offset shifts the bucket boundaries by the provided value, which must be
+// lower than the interval.
+func (agg *HistogramAggregation) Offset(offset float64) *HistogramAggregation {
+	agg.offset = offset
+	return agg
+}
+
+// MinDocCount sets the minimum number of documents a bucket must contain in
+// order to be returned. Set it to zero to return empty buckets as well, which
+// is usually needed for continuous charts.
+func (agg *HistogramAggregation) MinDocCount(count uint64) *HistogramAggregation {
+	agg.minDocCount = &count
+	return agg
+}
+
+// ExtendedBounds forces the histogram to start building buckets at min and
+// stop at max, even when no documents exist in those ranges.
+func (agg *HistogramAggregation) ExtendedBounds(min, max float64) *HistogramAggregation {
+	agg.extendedBounds = map[string]interface{}{
+		"min": min,
+		"max": max,
+	}
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *HistogramAggregation) Aggs(aggs ...Aggregation) *HistogramAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *HistogramAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field":    agg.field,
+		"interval": agg.interval,
+	}
+
+	if agg.offset != 0 {
+		innerMap["offset"] = agg.offset
+	}
+	if agg.minDocCount != nil {
+		innerMap["min_doc_count"] = *agg.minDocCount
+	}
+	if agg.extendedBounds != nil {
+		innerMap["extended_bounds"] = agg.extendedBounds
+	}
+
+	outerMap := map[string]interface{}{
+		"histogram": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// RangeAggregation represents an aggregation of type "range", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-range-aggregation.html
+type RangeAggregation struct {
+	name   string
+	field  string
+	keyed  *bool
+	ranges []aggRange
+	aggs   []Aggregation
+}
+
+type aggRange struct {
+	key  string
+	from interface{}
+	to   interface{}
+	mask string
+}
+
+// RangeAgg creates a new aggregation of type "range" with the provided name and
+// on the provided field. The method name includes the "Agg" suffix to prevent
+// conflict with the "range" query.
+func RangeAgg(name, field string) *RangeAggregation {
+	return &RangeAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *RangeAggregation) Name() string {
+	return agg.name
+}
+
+// AddRange adds a range bucket to the aggregation. Either bound may be nil to
+// create an open-ended range. As in ElasticSearch, "from" is inclusive and
+// "to" is exclusive.
+func (agg *RangeAggregation) AddRange(from, to interface{}) *RangeAggregation {
+	return agg.AddKeyedRange("", from, to)
+}
+
+// AddKeyedRange is the same as AddRange, but also sets a key for the bucket.
+func (agg *RangeAggregation) AddKeyedRange(
+	key string,
+	from, to interface{},
+) *RangeAggregation {
+	agg.ranges = append(agg.ranges, aggRange{
+		key:  key,
+		from: from,
+		to:   to,
+	})
+	return agg
+}
+
+// Keyed sets whether buckets are returned as a map keyed by the range keys,
+// rather than as an array.
+func (agg *RangeAggregation) Keyed(b bool) *RangeAggregation {
+	agg.keyed = &b
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *RangeAggregation) Aggs(aggs ...Aggregation) *RangeAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *RangeAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field":  agg.field,
+		"ranges": rangesMap(agg.ranges),
+	}
+	if agg.keyed != nil {
+		innerMap["keyed"] = *agg.keyed
+	}
+
+	outerMap := map[string]interface{}{
+		"range": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+// rangesMap returns the map representations of the provided range buckets, as
+// expected under a "ranges" key. Nil bounds are omitted.
+func rangesMap(ranges []aggRange) []map[string]interface{} {
+	maps := make([]map[string]interface{}, len(ranges))
+	for i, r := range ranges {
+		m := make(map[string]interface{})
+		if r.key != "" {
+			m["key"] = r.key
+		}
+		if r.from != nil {
+			m["from"] = r.from
 		}
-		outerMap["aggs"] = subAggs
+		if r.to != nil {
+			m["to"] = r.to
+		}
+		if r.mask != "" {
+			m["mask"] = r.mask
+		}
+		maps[i] = m
+	}
+
+	return maps
+}
+
+//----------------------------------------------------------------------------//
+
+// DateRangeAggregation represents an aggregation of type "date_range", as
+// described in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-daterange-aggregation.html
+type DateRangeAggregation struct {
+	name     string
+	field    string
+	format   string
+	timeZone string
+	keyed    *bool
+	ranges   []aggRange
+	aggs     []Aggregation
+}
+
+// DateRangeAgg creates a new aggregation of type "date_range" with the
+// provided name and on the provided date field. The method name includes the
+// "Agg" suffix to be consistent with RangeAgg.
+func DateRangeAgg(name, field string) *DateRangeAggregation {
+	return &DateRangeAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *DateRangeAggregation) Name() string {
+	return agg.name
+}
+
+// Format sets the date format used to parse the bounds of the ranges and to
+// format the keys of the buckets.
+func (agg *DateRangeAggregation) Format(format string) *DateRangeAggregation {
+	agg.format = format
+	return agg
+}
+
+// TimeZone sets the time zone used to convert the bounds of the ranges,
+// including date math expressions.
+func (agg *DateRangeAggregation) TimeZone(tz string) *DateRangeAggregation {
+	agg.timeZone = tz
+	return agg
+}
+
+// AddRange adds a range bucket to the aggregation. Bounds can be dates or date
+// math expressions such as "now-1M/M", which are sent as-is. Either bound may
+// be nil to create an open-ended range. As in ElasticSearch, "from" is
+// inclusive and "to" is exclusive.
+func (agg *DateRangeAggregation) AddRange(from, to interface{}) *DateRangeAggregation {
+	return agg.AddKeyedRange("", from, to)
+}
+
+// AddKeyedRange is the same as AddRange, but also sets a key for the bucket.
+func (agg *DateRangeAggregation) AddKeyedRange(
+	key string,
+	from, to interface{},
+) *DateRangeAggregation {
+	agg.ranges = append(agg.ranges, aggRange{
+		key:  key,
+		from: from,
+		to:   to,
+	})
+	return agg
+}
+
+// Keyed sets whether buckets are returned as a map keyed by the range keys,
+// rather than as an array.
+func (agg *DateRangeAggregation) Keyed(b bool) *DateRangeAggregation {
+	agg.keyed = &b
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *DateRangeAggregation) Aggs(aggs ...Aggregation) *DateRangeAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *DateRangeAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field":  agg.field,
+		"ranges": rangesMap(agg.ranges),
+	}
+	if agg.format != "" {
+		innerMap["format"] = agg.format
+	}
+	if agg.timeZone != "" {
+		innerMap["time_zone"] = agg.timeZone
+	}
+	if agg.keyed != nil {
+		innerMap["keyed"] = *agg.keyed
+	}
+
+	outerMap := map[string]interface{}{
+		"date_range": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// IPRangeAggregation represents an aggregation of type "ip_range", as
+// described in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-iprange-aggregation.html
+type IPRangeAggregation struct {
+	name   string
+	field  string
+	ranges []aggRange
+	aggs   []Aggregation
+}
+
+// IPRangeAgg creates a new aggregation of type "ip_range" with the provided
+// name and on the provided IP field. The method name includes the "Agg" suffix
+// to be consistent with RangeAgg.
+func IPRangeAgg(name, field string) *IPRangeAggregation {
+	return &IPRangeAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *IPRangeAggregation) Name() string {
+	return agg.name
+}
+
+// AddRange adds a range bucket between the provided IP addresses. Either bound
+// may be empty to create an open-ended range. As in ElasticSearch, "from" is
+// inclusive and "to" is exclusive.
+func (agg *IPRangeAggregation) AddRange(from, to string) *IPRangeAggregation {
+	r := aggRange{}
+	if from != "" {
+		r.from = from
+	}
+	if to != "" {
+		r.to = to
+	}
+	agg.ranges = append(agg.ranges, r)
+	return agg
+}
+
+// AddMask adds a range bucket for the provided CIDR mask, e.g. "10.0.0.0/25".
+// It can be mixed with ranges added via AddRange.
+func (agg *IPRangeAggregation) AddMask(cidr string) *IPRangeAggregation {
+	agg.ranges = append(agg.ranges, aggRange{mask: cidr})
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *IPRangeAggregation) Aggs(aggs ...Aggregation) *IPRangeAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *IPRangeAggregation) Map() map[string]interface{} {
+	outerMap := map[string]interface{}{
+		"ip_range": map[string]interface{}{
+			"field":  agg.field,
+			"ranges": rangesMap(agg.ranges),
+		},
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// MissingAggregation represents an aggregation of type "missing", as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-missing-aggregation.html
+type MissingAggregation struct {
+	name  string
+	field string
+	aggs  []Aggregation
+}
+
+// Missing creates a new aggregation of type "missing" with the provided name
+// and on the provided field. It creates a single bucket of all documents
+// lacking a value for the field, and is usually combined with a sibling
+// aggregation (e.g. TermsAgg) on the same field covering documents that do
+// have a value.
+func Missing(name, field string) *MissingAggregation {
+	return &MissingAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *MissingAggregation) Name() string {
+	return agg.name
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *MissingAggregation) Aggs(aggs ...Aggregation) *MissingAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *MissingAggregation) Map() map[string]interface{} {
+	outerMap := map[string]interface{}{
+		"missing": map[string]interface{}{
+			"field": agg.field,
+		},
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// GlobalAggregation represents an aggregation of type "global", as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-global-aggregation.html
+type GlobalAggregation struct {
+	name string
+	aggs []Aggregation
+}
+
+// Global creates a new aggregation of type "global" with the provided name. It
+// creates a single bucket of all documents in the searched indices, regardless
+// of the search query, so that its sub-aggregations can be compared with those
+// computed over the matching documents only. It is only valid as a top-level
+// aggregation.
+func Global(name string) *GlobalAggregation {
+	return &GlobalAggregation{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *GlobalAggregation) Name() string {
+	return agg.name
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *GlobalAggregation) Aggs(aggs ...Aggregation) *GlobalAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *GlobalAggregation) Map() map[string]interface{} {
+	outerMap := map[string]interface{}{
+		"global": map[string]interface{}{},
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
 	}
 
 	return outerMap
@@ -0,0 +1,325 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestBucketAggs(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"terms: faceted navigation with sub-aggregations",
+			TermsAgg("genres", "genre").
+				Size(10).
+				MinDocCount(2).
+				Order(map[string]string{"_count": "desc"}).
+				Include("rock.*").
+				Exclude("rock_n_roll", "rockabilly").
+				Aggs(Avg("avg_price", "price")),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field":         "genre",
+					"size":          10,
+					"min_doc_count": 2,
+					"order": map[string]interface{}{
+						"_count": "desc",
+					},
+					"include": "rock.*",
+					"exclude": []string{"rock_n_roll", "rockabilly"},
+				},
+				"aggs": map[string]interface{}{
+					"avg_price": map[string]interface{}{
+						"avg": map[string]interface{}{
+							"field": "price",
+						},
+					},
+				},
+			},
+		},
+		{
+			"terms: ordered by a sub-aggregation metric",
+			TermsAgg("brands", "brand").
+				OrderBy("avg_price", OrderDesc).
+				Aggs(Avg("avg_price", "price")),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "brand",
+					"order": map[string]interface{}{
+						"avg_price": "desc",
+					},
+				},
+				"aggs": map[string]interface{}{
+					"avg_price": map[string]interface{}{
+						"avg": map[string]interface{}{
+							"field": "price",
+						},
+					},
+				},
+			},
+		},
+		{
+			"terms: multiple order criteria",
+			TermsAgg("brands", "brand").
+				OrderBy("_count", OrderDesc).
+				OrderBy("_key", OrderAsc),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "brand",
+					"order": []map[string]interface{}{
+						{"_count": "desc"},
+						{"_key": "asc"},
+					},
+				},
+			},
+		},
+		{
+			"terms: single exclude is a pattern",
+			TermsAgg("tags", "tags").Exclude("water_.*"),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field":   "tags",
+					"exclude": "water_.*",
+				},
+			},
+		},
+		{
+			"date_histogram: monthly sales with nested metric",
+			DateHistogram("sales_over_time", "date").
+				CalendarInterval("month").
+				TimeZone("Europe/Berlin").
+				Format("yyyy-MM").
+				MinDocCount(0).
+				ExtendedBounds("2020-01", "2020-12").
+				Aggs(Sum("sales", "price")),
+			map[string]interface{}{
+				"date_histogram": map[string]interface{}{
+					"field":             "date",
+					"calendar_interval": "month",
+					"time_zone":         "Europe/Berlin",
+					"format":            "yyyy-MM",
+					"min_doc_count":     0,
+					"extended_bounds": map[string]interface{}{
+						"min": "2020-01",
+						"max": "2020-12",
+					},
+				},
+				"aggs": map[string]interface{}{
+					"sales": map[string]interface{}{
+						"sum": map[string]interface{}{
+							"field": "price",
+						},
+					},
+				},
+			},
+		},
+		{
+			"date_histogram: calendar interval takes precedence",
+			DateHistogram("per_day", "date").
+				FixedInterval("24h").
+				CalendarInterval("1d"),
+			map[string]interface{}{
+				"date_histogram": map[string]interface{}{
+					"field":             "date",
+					"calendar_interval": "1d",
+				},
+			},
+		},
+		{
+			"date_histogram: fixed interval",
+			DateHistogram("per_90m", "date").FixedInterval("90m"),
+			map[string]interface{}{
+				"date_histogram": map[string]interface{}{
+					"field":          "date",
+					"fixed_interval": "90m",
+				},
+			},
+		},
+		{
+			"range: price buckets",
+			RangeAgg("price_ranges", "price").
+				AddRange(nil, 100).
+				AddRange(100, 200).
+				AddRange(200, nil),
+			map[string]interface{}{
+				"range": map[string]interface{}{
+					"field": "price",
+					"ranges": []map[string]interface{}{
+						{"to": 100},
+						{"from": 100, "to": 200},
+						{"from": 200},
+					},
+				},
+			},
+		},
+		{
+			"range: keyed with sub-aggregations",
+			RangeAgg("price_ranges", "price").
+				Keyed(true).
+				AddKeyedRange("cheap", nil, 100).
+				AddKeyedRange("expensive", 100, nil).
+				Aggs(Stats("price_stats", "price")),
+			map[string]interface{}{
+				"range": map[string]interface{}{
+					"field": "price",
+					"keyed": true,
+					"ranges": []map[string]interface{}{
+						{"key": "cheap", "to": 100},
+						{"key": "expensive", "from": 100},
+					},
+				},
+				"aggs": map[string]interface{}{
+					"price_stats": map[string]interface{}{
+						"stats": map[string]interface{}{
+							"field": "price",
+						},
+					},
+				},
+			},
+		},
+		{
+			"histogram",
+			Histogram("prices", "price").Interval(50),
+			map[string]interface{}{
+				"histogram": map[string]interface{}{
+					"field":    "price",
+					"interval": 50,
+				},
+			},
+		},
+		{
+			"histogram with empty buckets and sub-aggregations",
+			Histogram("prices", "price").
+				Interval(50).
+				Offset(10).
+				MinDocCount(0).
+				ExtendedBounds(0, 500).
+				Aggs(Avg("avg_rating", "rating")),
+			map[string]interface{}{
+				"histogram": map[string]interface{}{
+					"field":         "price",
+					"interval":      50,
+					"offset":        10,
+					"min_doc_count": 0,
+					"extended_bounds": map[string]interface{}{
+						"min": 0,
+						"max": 500,
+					},
+				},
+				"aggs": map[string]interface{}{
+					"avg_rating": map[string]interface{}{
+						"avg": map[string]interface{}{
+							"field": "rating",
+						},
+					},
+				},
+			},
+		},
+		{
+			"missing alongside terms on the same field",
+			Search().Aggs(
+				TermsAgg("promo_codes", "promo_code"),
+				Missing("no_promo_code", "promo_code").Aggs(Sum("revenue", "total")),
+			),
+			map[string]interface{}{
+				"aggs": map[string]interface{}{
+					"promo_codes": map[string]interface{}{
+						"terms": map[string]interface{}{
+							"field": "promo_code",
+						},
+					},
+					"no_promo_code": map[string]interface{}{
+						"missing": map[string]interface{}{
+							"field": "promo_code",
+						},
+						"aggs": map[string]interface{}{
+							"revenue": map[string]interface{}{
+								"sum": map[string]interface{}{
+									"field": "total",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"global without sub-aggregations",
+			Global("all_products"),
+			map[string]interface{}{
+				"global": map[string]interface{}{},
+			},
+		},
+		{
+			"global next to a filtered metric",
+			Search().
+				Query(Term("type", "t-shirt")).
+				Aggs(
+					Avg("avg_price", "price"),
+					Global("all_products").Aggs(Avg("avg_price", "price")),
+				),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"term": map[string]interface{}{
+						"type": map[string]interface{}{
+							"value": "t-shirt",
+						},
+					},
+				},
+				"aggs": map[string]interface{}{
+					"avg_price": map[string]interface{}{
+						"avg": map[string]interface{}{
+							"field": "price",
+						},
+					},
+					"all_products": map[string]interface{}{
+						"global": map[string]interface{}{},
+						"aggs": map[string]interface{}{
+							"avg_price": map[string]interface{}{
+								"avg": map[string]interface{}{
+									"field": "price",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"date_range with date math",
+			DateRangeAgg("order_age", "created_at").
+				Format("yyyy-MM-dd").
+				TimeZone("Europe/Paris").
+				AddKeyedRange("this_month", "now/M", nil).
+				AddKeyedRange("last_month", "now-1M/M", "now/M").
+				AddKeyedRange("older", nil, "now-1M/M"),
+			map[string]interface{}{
+				"date_range": map[string]interface{}{
+					"field":     "created_at",
+					"format":    "yyyy-MM-dd",
+					"time_zone": "Europe/Paris",
+					"ranges": []map[string]interface{}{
+						{"key": "this_month", "from": "now/M"},
+						{"key": "last_month", "from": "now-1M/M", "to": "now/M"},
+						{"key": "older", "to": "now-1M/M"},
+					},
+				},
+			},
+		},
+		{
+			"ip_range mixing ranges and masks",
+			IPRangeAgg("subnets", "client_ip").
+				AddRange("", "10.0.0.5").
+				AddRange("10.0.0.5", "").
+				AddMask("10.0.0.0/25"),
+			map[string]interface{}{
+				"ip_range": map[string]interface{}{
+					"field": "client_ip",
+					"ranges": []map[string]interface{}{
+						{"to": "10.0.0.5"},
+						{"from": "10.0.0.5"},
+						{"mask": "10.0.0.0/25"},
+					},
+				},
+			},
+		},
+	})
+}
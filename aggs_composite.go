@@ -0,0 +1,180 @@
+package elasticsearch
+
+// CompositeAggregation represents an aggregation of type "composite", as
+// described in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-composite-aggregation.html
+type CompositeAggregation struct {
+	name    string
+	size    *uint64
+	sources []CompositeSource
+	after   map[string]interface{}
+	aggs    []Aggregation
+}
+
+// Composite creates a new aggregation of type "composite" with the provided
+// name. It creates a bucket for every unique combination of the values of its
+// sources, and allows paging through all buckets via After, which makes it
+// suitable for enumerating high-cardinality groupings.
+func Composite(name string) *CompositeAggregation {
+	return &CompositeAggregation{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *CompositeAggregation) Name() string {
+	return agg.name
+}
+
+// Size sets the number of buckets to return per page (ElasticSearch defaults
+// to 10).
+func (agg *CompositeAggregation) Size(size uint64) *CompositeAggregation {
+	agg.size = &size
+	return agg
+}
+
+// Sources adds one or more value sources to the aggregation. The order of the
+// sources is significant, as it determines the order of the keys of the
+// buckets.
+func (agg *CompositeAggregation) Sources(sources ...CompositeSource) *CompositeAggregation {
+	agg.sources = append(agg.sources, sources...)
+	return agg
+}
+
+// After sets the key of the last bucket of the previous page (as returned in
+// the "after_key" of the response), so that the next page of buckets is
+// returned.
+func (agg *CompositeAggregation) After(key map[string]interface{}) *CompositeAggregation {
+	agg.after = key
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *CompositeAggregation) Aggs(aggs ...Aggregation) *CompositeAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *CompositeAggregation) Map() map[string]interface{} {
+	sources := make([]map[string]interface{}, len(agg.sources))
+	for i, src := range agg.sources {
+		sources[i] = map[string]interface{}{
+			src.Name(): src.Map(),
+		}
+	}
+
+	innerMap := map[string]interface{}{
+		"sources": sources,
+	}
+	if agg.size != nil {
+		innerMap["size"] = *agg.size
+	}
+	if agg.after != nil {
+		innerMap["after"] = agg.after
+	}
+
+	outerMap := map[string]interface{}{
+		"composite": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// CompositeSource is the interface implemented by the value sources of a
+// composite aggregation. Like the Aggregation interface, it is an extension of
+// the Mappable interface that includes the name of the source, which is used
+// as its key in the buckets.
+type CompositeSource interface {
+	Mappable
+	Name() string
+}
+
+// ValuesSource represents a value source of a composite aggregation, as
+// created by TermsSource, HistogramSource and DateHistogramSource.
+type ValuesSource struct {
+	name          string
+	kind          string
+	params        map[string]interface{}
+	order         Order
+	missingBucket *bool
+}
+
+// TermsSource creates a new "terms" value source for a composite aggregation,
+// with the provided name and on the provided field.
+func TermsSource(name, field string) *ValuesSource {
+	return newValuesSource(name, "terms", field)
+}
+
+// HistogramSource creates a new "histogram" value source for a composite
+// aggregation, with the provided name and on the provided numeric field, using
+// the provided interval.
+func HistogramSource(name, field string, interval float64) *ValuesSource {
+	src := newValuesSource(name, "histogram", field)
+	src.params["interval"] = interval
+	return src
+}
+
+// DateHistogramSource creates a new "date_histogram" value source for a
+// composite aggregation, with the provided name and on the provided date
+// field, using the provided calendar interval (e.g. "1d" or "month").
+func DateHistogramSource(name, field, interval string) *ValuesSource {
+	src := newValuesSource(name, "date_histogram", field)
+	src.params["calendar_interval"] = interval
+	return src
+}
+
+func newValuesSource(name, kind, field string) *ValuesSource {
+	return &ValuesSource{
+		name: name,
+		kind: kind,
+		params: map[string]interface{}{
+			"field": field,
+		},
+	}
+}
+
+// Name returns the name of the value source, allowing implementation of the
+// CompositeSource interface.
+func (src *ValuesSource) Name() string {
+	return src.name
+}
+
+// Order sets the sort order of the values of the source.
+func (src *ValuesSource) Order(order Order) *ValuesSource {
+	src.order = order
+	return src
+}
+
+// MissingBucket sets whether documents without a value for the source's field
+// are included in the buckets, with a null value for the source.
+func (src *ValuesSource) MissingBucket(b bool) *ValuesSource {
+	src.missingBucket = &b
+	return src
+}
+
+// Map returns a map representation of the value source, thus implementing the
+// Mappable interface.
+func (src *ValuesSource) Map() map[string]interface{} {
+	innerMap := make(map[string]interface{}, len(src.params)+2)
+	for k, v := range src.params {
+		innerMap[k] = v
+	}
+	if src.order != "" {
+		innerMap["order"] = src.order
+	}
+	if src.missingBucket != nil {
+		innerMap["missing_bucket"] = *src.missingBucket
+	}
+
+	return map[string]interface{}{
+		src.kind: innerMap,
+	}
+}
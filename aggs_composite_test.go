@@ -0,0 +1,85 @@
+package elasticsearch
+
+import "testing"
+
+func TestCompositeAggs(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"composite agg: single source",
+			Composite("products").Sources(TermsSource("product", "product_id")),
+			map[string]interface{}{
+				"composite": map[string]interface{}{
+					"sources": []map[string]interface{}{
+						{
+							"product": map[string]interface{}{
+								"terms": map[string]interface{}{
+									"field": "product_id",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"composite agg: ordered sources with pagination",
+			Composite("sales").
+				Size(100).
+				Sources(
+					DateHistogramSource("day", "timestamp", "1d").Order(OrderDesc),
+					TermsSource("shop", "shop_id").MissingBucket(true),
+					HistogramSource("price", "price", 50),
+				).
+				After(map[string]interface{}{
+					"day":   1577836800000,
+					"shop":  "s-42",
+					"price": 100,
+				}).
+				Aggs(Sum("revenue", "total")),
+			map[string]interface{}{
+				"composite": map[string]interface{}{
+					"size": 100,
+					"sources": []map[string]interface{}{
+						{
+							"day": map[string]interface{}{
+								"date_histogram": map[string]interface{}{
+									"field":             "timestamp",
+									"calendar_interval": "1d",
+									"order":             "desc",
+								},
+							},
+						},
+						{
+							"shop": map[string]interface{}{
+								"terms": map[string]interface{}{
+									"field":          "shop_id",
+									"missing_bucket": true,
+								},
+							},
+						},
+						{
+							"price": map[string]interface{}{
+								"histogram": map[string]interface{}{
+									"field":    "price",
+									"interval": 50,
+								},
+							},
+						},
+					},
+					"after": map[string]interface{}{
+						"day":   1577836800000,
+						"shop":  "s-42",
+						"price": 100,
+					},
+				},
+				"aggs": map[string]interface{}{
+					"revenue": map[string]interface{}{
+						"sum": map[string]interface{}{
+							"field": "total",
+						},
+					},
+				},
+			},
+		},
+	})
+}
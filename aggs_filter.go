@@ -1,13 +1,19 @@
 package elasticsearch
 
+// FilterAggregation represents an aggregation of type "filter", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-filter-aggregation.html
 type FilterAggregation struct {
 	name   string
 	filter Mappable
 	aggs   []Aggregation
 }
 
-// Filter creates a new aggregation of type "filter". The method name includes
-// the "Agg" suffix to prevent conflict with the "filter" query.
+// FilterAgg creates a new aggregation of type "filter" with the provided name,
+// creating a single bucket of the documents matching the provided query, in
+// which sub-aggregations are computed. The method name includes the "Agg"
+// suffix to prevent conflict with the "filter" query.
 func FilterAgg(name string, filter Mappable) *FilterAggregation {
 	return &FilterAggregation{
 		name:   name,
@@ -20,7 +26,7 @@ func (agg *FilterAggregation) Name() string {
 	return agg.name
 }
 
-// Filter sets the filter items
+// Filter sets the query documents of the bucket must match.
 func (agg *FilterAggregation) Filter(filter Mappable) *FilterAggregation {
 	agg.filter = filter
 	return agg
@@ -32,17 +38,166 @@ func (agg *FilterAggregation) Aggs(aggs ...Aggregation) *FilterAggregation {
 	return agg
 }
 
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
 func (agg *FilterAggregation) Map() map[string]interface{} {
 	outerMap := map[string]interface{}{
 		"filter": agg.filter.Map(),
 	}
 
 	if len(agg.aggs) > 0 {
-		subAggs := make(map[string]map[string]interface{})
-		for _, sub := range agg.aggs {
-			subAggs[sub.Name()] = sub.Map()
-		}
-		outerMap["aggs"] = subAggs
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// FiltersAggregation represents an aggregation of type "filters", as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-filters-aggregation.html
+type FiltersAggregation struct {
+	name     string
+	filters  map[string]Mappable
+	otherKey string
+	aggs     []Aggregation
+}
+
+// FiltersAgg creates a new aggregation of type "filters", with the provided
+// name. The method name includes the "Agg" suffix to be consistent with
+// FilterAgg.
+func FiltersAgg(name string) *FiltersAggregation {
+	return &FiltersAggregation{
+		name:    name,
+		filters: make(map[string]Mappable),
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *FiltersAggregation) Name() string {
+	return agg.name
+}
+
+// AddFilter adds a named bucket, containing the documents matching the
+// provided query.
+func (agg *FiltersAggregation) AddFilter(key string, q Mappable) *FiltersAggregation {
+	agg.filters[key] = q
+	return agg
+}
+
+// OtherBucket enables a bucket for all documents not matching any of the
+// filters, under the provided key.
+func (agg *FiltersAggregation) OtherBucket(key string) *FiltersAggregation {
+	agg.otherKey = key
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *FiltersAggregation) Aggs(aggs ...Aggregation) *FiltersAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *FiltersAggregation) Map() map[string]interface{} {
+	filters := make(map[string]interface{}, len(agg.filters))
+	for key, q := range agg.filters {
+		filters[key] = q.Map()
+	}
+
+	innerMap := map[string]interface{}{
+		"filters": filters,
+	}
+	if agg.otherKey != "" {
+		innerMap["other_bucket_key"] = agg.otherKey
+	}
+
+	outerMap := map[string]interface{}{
+		"filters": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// AdjacencyMatrixAggregation represents an aggregation of type
+// "adjacency_matrix", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-adjacency-matrix-aggregation.html
+type AdjacencyMatrixAggregation struct {
+	name      string
+	filters   map[string]Mappable
+	separator string
+	aggs      []Aggregation
+}
+
+// AdjacencyMatrix creates a new aggregation of type "adjacency_matrix", with
+// the provided name. It returns a bucket for every filter, and for every pair
+// of filters whose intersection is not empty, keyed by the keys of both filters
+// joined by the separator (e.g. "A&B").
+func AdjacencyMatrix(name string) *AdjacencyMatrixAggregation {
+	return &AdjacencyMatrixAggregation{
+		name:    name,
+		filters: make(map[string]Mappable),
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *AdjacencyMatrixAggregation) Name() string {
+	return agg.name
+}
+
+// AddFilter adds a named filter to the matrix.
+func (agg *AdjacencyMatrixAggregation) AddFilter(
+	key string,
+	q Mappable,
+) *AdjacencyMatrixAggregation {
+	agg.filters[key] = q
+	return agg
+}
+
+// Separator sets the separator used to join the keys of intersecting filters
+// (ElasticSearch defaults to "&").
+func (agg *AdjacencyMatrixAggregation) Separator(sep string) *AdjacencyMatrixAggregation {
+	agg.separator = sep
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation, which are computed for every
+// cell of the matrix.
+func (agg *AdjacencyMatrixAggregation) Aggs(aggs ...Aggregation) *AdjacencyMatrixAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *AdjacencyMatrixAggregation) Map() map[string]interface{} {
+	filters := make(map[string]interface{}, len(agg.filters))
+	for key, q := range agg.filters {
+		filters[key] = q.Map()
+	}
+
+	innerMap := map[string]interface{}{
+		"filters": filters,
+	}
+	if agg.separator != "" {
+		innerMap["separator"] = agg.separator
+	}
+
+	outerMap := map[string]interface{}{
+		"adjacency_matrix": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
 	}
 
 	return outerMap
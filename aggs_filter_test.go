@@ -38,5 +38,106 @@ func TestFilterAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"filter agg: as a sub-aggregation",
+			TermsAgg("per_customer", "customer_id").
+				Aggs(FilterAgg("completed", Term("status", "completed")).
+					Aggs(Avg("avg_order_value", "total"))),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "customer_id",
+				},
+				"aggs": map[string]interface{}{
+					"completed": map[string]interface{}{
+						"filter": map[string]interface{}{
+							"term": map[string]interface{}{
+								"status": map[string]interface{}{
+									"value": "completed",
+								},
+							},
+						},
+						"aggs": map[string]interface{}{
+							"avg_order_value": map[string]interface{}{
+								"avg": map[string]interface{}{
+									"field": "total",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"filters agg: keyed buckets with other bucket and aggs",
+			FiltersAgg("messages").
+				AddFilter("errors", Match("body", "error")).
+				AddFilter("warnings", Match("body", "warning")).
+				OtherBucket("other_messages").
+				Aggs(ValueCount("count", "id")),
+			map[string]interface{}{
+				"filters": map[string]interface{}{
+					"filters": map[string]interface{}{
+						"errors": map[string]interface{}{
+							"match": map[string]interface{}{
+								"body": map[string]interface{}{
+									"query": "error",
+								},
+							},
+						},
+						"warnings": map[string]interface{}{
+							"match": map[string]interface{}{
+								"body": map[string]interface{}{
+									"query": "warning",
+								},
+							},
+						},
+					},
+					"other_bucket_key": "other_messages",
+				},
+				"aggs": map[string]interface{}{
+					"count": map[string]interface{}{
+						"value_count": map[string]interface{}{
+							"field": "id",
+						},
+					},
+				},
+			},
+		},
+		{
+			"adjacency_matrix agg",
+			AdjacencyMatrix("tag_overlap").
+				AddFilter("go", Term("tags", "go")).
+				AddFilter("rust", Term("tags", "rust")).
+				Separator("+").
+				Aggs(Avg("avg_likes", "likes")),
+			map[string]interface{}{
+				"adjacency_matrix": map[string]interface{}{
+					"filters": map[string]interface{}{
+						"go": map[string]interface{}{
+							"term": map[string]interface{}{
+								"tags": map[string]interface{}{
+									"value": "go",
+								},
+							},
+						},
+						"rust": map[string]interface{}{
+							"term": map[string]interface{}{
+								"tags": map[string]interface{}{
+									"value": "rust",
+								},
+							},
+						},
+					},
+					"separator": "+",
+				},
+				"aggs": map[string]interface{}{
+					"avg_likes": map[string]interface{}{
+						"avg": map[string]interface{}{
+							"field": "likes",
+						},
+					},
+				},
+			},
+		},
 	})
 }
@@ -0,0 +1,269 @@
+package elasticsearch
+
+import (
+	"fmt"
+
+	"github.com/fatih/structs"
+)
+
+// GeoBoundsAgg represents an aggregation of type "geo_bounds", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-metrics-geobounds-aggregation.html
+type GeoBoundsAgg struct {
+	*BaseAgg `structs:",flatten"`
+
+	// WrapLon denotes whether the bounding box is allowed to overlap the
+	// international date line
+	WrapLon *bool `structs:"wrap_longitude,omitempty"`
+}
+
+// GeoBounds creates a new aggregation of type "geo_bounds", with the provided
+// name and on the provided geo-point field.
+func GeoBounds(name, field string) *GeoBoundsAgg {
+	return &GeoBoundsAgg{
+		BaseAgg: newBaseAgg("geo_bounds", name, field),
+	}
+}
+
+// WrapLongitude sets whether the bounding box is allowed to overlap the
+// international date line (ElasticSearch defaults to true).
+func (agg *GeoBoundsAgg) WrapLongitude(b bool) *GeoBoundsAgg {
+	agg.WrapLon = &b
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *GeoBoundsAgg) Map() map[string]interface{} {
+	return map[string]interface{}{
+		agg.apiName: structs.Map(agg),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// GeoHashGridAggregation represents an aggregation of type "geohash_grid", as
+// described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-bucket-geohashgrid-aggregation.html
+type GeoHashGridAggregation struct {
+	name      string
+	field     string
+	precision *uint8
+	size      *uint64
+	shardSize *uint64
+	bounds    map[string]interface{}
+	aggs      []Aggregation
+}
+
+// GeoHashGrid creates a new aggregation of type "geohash_grid", with the
+// provided name and on the provided geo-point field.
+func GeoHashGrid(name, field string) *GeoHashGridAggregation {
+	return &GeoHashGridAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *GeoHashGridAggregation) Name() string {
+	return agg.name
+}
+
+// Precision sets the length of the geohashes used to define the cells, between
+// 1 and 12 (ElasticSearch defaults to 5). The value is sent as-is; use Validate
+// to check that it is within range.
+func (agg *GeoHashGridAggregation) Precision(p uint8) *GeoHashGridAggregation {
+	agg.precision = &p
+	return agg
+}
+
+// Validate checks that the precision, if set, is between 1 and 12, as
+// ElasticSearch rejects the aggregation otherwise.
+func (agg *GeoHashGridAggregation) Validate() error {
+	if agg.precision != nil && (*agg.precision < 1 || *agg.precision > 12) {
+		return fmt.Errorf(
+			"geohash_grid precision must be between 1 and 12, got %d",
+			*agg.precision,
+		)
+	}
+
+	return nil
+}
+
+// Size sets the maximum number of buckets to return.
+func (agg *GeoHashGridAggregation) Size(size uint64) *GeoHashGridAggregation {
+	agg.size = &size
+	return agg
+}
+
+// ShardSize sets the maximum number of buckets to return from each shard.
+func (agg *GeoHashGridAggregation) ShardSize(size uint64) *GeoHashGridAggregation {
+	agg.shardSize = &size
+	return agg
+}
+
+// Bounds restricts the cells to those intersecting the box defined by the
+// provided top and bottom latitudes and left and right longitudes.
+func (agg *GeoHashGridAggregation) Bounds(
+	top, left, bottom, right float64,
+) *GeoHashGridAggregation {
+	agg.bounds = map[string]interface{}{
+		"top_left":     GeoPoint{Lat: top, Lon: left}.Map(),
+		"bottom_right": GeoPoint{Lat: bottom, Lon: right}.Map(),
+	}
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *GeoHashGridAggregation) Aggs(aggs ...Aggregation) *GeoHashGridAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *GeoHashGridAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field": agg.field,
+	}
+
+	if agg.precision != nil {
+		innerMap["precision"] = *agg.precision
+	}
+	if agg.size != nil {
+		innerMap["size"] = *agg.size
+	}
+	if agg.shardSize != nil {
+		innerMap["shard_size"] = *agg.shardSize
+	}
+	if agg.bounds != nil {
+		innerMap["bounds"] = agg.bounds
+	}
+
+	outerMap := map[string]interface{}{
+		"geohash_grid": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// GeoDistanceAggregation represents an aggregation of type "geo_distance", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-geodistance-aggregation.html
+type GeoDistanceAggregation struct {
+	name         string
+	field        string
+	origin       *GeoPoint
+	unit         string
+	distanceType DistanceType
+	keyed        *bool
+	ranges       []aggRange
+	aggs         []Aggregation
+}
+
+// GeoDistanceAgg creates a new aggregation of type "geo_distance" with the
+// provided name and on the provided geo-point field, bucketing documents by
+// their distance from an origin, set via the Origin method. The method name
+// includes the "Agg" suffix to prevent conflict with the "geo_distance" query.
+func GeoDistanceAgg(name, field string) *GeoDistanceAggregation {
+	return &GeoDistanceAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *GeoDistanceAggregation) Name() string {
+	return agg.name
+}
+
+// Origin sets the point distances are computed from.
+func (agg *GeoDistanceAggregation) Origin(lat, lon float64) *GeoDistanceAggregation {
+	agg.origin = &GeoPoint{Lat: lat, Lon: lon}
+	return agg
+}
+
+// Unit sets the unit of the range bounds, e.g. "km" (ElasticSearch defaults
+// to meters).
+func (agg *GeoDistanceAggregation) Unit(unit string) *GeoDistanceAggregation {
+	agg.unit = unit
+	return agg
+}
+
+// DistanceType sets how distances are computed.
+func (agg *GeoDistanceAggregation) DistanceType(t DistanceType) *GeoDistanceAggregation {
+	agg.distanceType = t
+	return agg
+}
+
+// AddRange adds a distance range bucket to the aggregation. Either bound may
+// be nil to create an open-ended range. As in ElasticSearch, "from" is
+// inclusive and "to" is exclusive.
+func (agg *GeoDistanceAggregation) AddRange(from, to interface{}) *GeoDistanceAggregation {
+	return agg.AddKeyedRange("", from, to)
+}
+
+// AddKeyedRange is the same as AddRange, but also sets a key for the bucket.
+func (agg *GeoDistanceAggregation) AddKeyedRange(
+	key string,
+	from, to interface{},
+) *GeoDistanceAggregation {
+	agg.ranges = append(agg.ranges, aggRange{
+		key:  key,
+		from: from,
+		to:   to,
+	})
+	return agg
+}
+
+// Keyed sets whether buckets are returned as a map keyed by the range keys,
+// rather than as an array.
+func (agg *GeoDistanceAggregation) Keyed(b bool) *GeoDistanceAggregation {
+	agg.keyed = &b
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *GeoDistanceAggregation) Aggs(aggs ...Aggregation) *GeoDistanceAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *GeoDistanceAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field":  agg.field,
+		"ranges": rangesMap(agg.ranges),
+	}
+	if agg.origin != nil {
+		innerMap["origin"] = agg.origin.Map()
+	}
+	if agg.unit != "" {
+		innerMap["unit"] = agg.unit
+	}
+	if agg.distanceType != 0 {
+		innerMap["distance_type"] = agg.distanceType.String()
+	}
+	if agg.keyed != nil {
+		innerMap["keyed"] = *agg.keyed
+	}
+
+	outerMap := map[string]interface{}{
+		"geo_distance": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
@@ -0,0 +1,100 @@
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestGeoAggs(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"geo_bounds: simple",
+			GeoBounds("viewport", "location"),
+			map[string]interface{}{
+				"geo_bounds": map[string]interface{}{
+					"field": "location",
+				},
+			},
+		},
+		{
+			"geo_bounds: alongside a geo_bounding_box query",
+			Search().
+				Query(GeoBoundingBox("location").
+					TopLeft(52.5, 13.3).
+					BottomRight(52.4, 13.5)).
+				Aggs(GeoBounds("viewport", "location").WrapLongitude(false)),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"geo_bounding_box": map[string]interface{}{
+						"location": map[string]interface{}{
+							"top_left": map[string]interface{}{
+								"lat": 52.5,
+								"lon": 13.3,
+							},
+							"bottom_right": map[string]interface{}{
+								"lat": 52.4,
+								"lon": 13.5,
+							},
+						},
+					},
+				},
+				"aggs": map[string]interface{}{
+					"viewport": map[string]interface{}{
+						"geo_bounds": map[string]interface{}{
+							"field":          "location",
+							"wrap_longitude": false,
+						},
+					},
+				},
+			},
+		},
+		{
+			"geohash_grid: with bounds and sub-aggregations",
+			GeoHashGrid("cells", "location").
+				Precision(5).
+				Size(1000).
+				Bounds(52.5, 13.3, 52.4, 13.5).
+				Aggs(GeoBounds("cell_bounds", "location")),
+			map[string]interface{}{
+				"geohash_grid": map[string]interface{}{
+					"field":     "location",
+					"precision": 5,
+					"size":      1000,
+					"bounds": map[string]interface{}{
+						"top_left": map[string]interface{}{
+							"lat": 52.5,
+							"lon": 13.3,
+						},
+						"bottom_right": map[string]interface{}{
+							"lat": 52.4,
+							"lon": 13.5,
+						},
+					},
+				},
+				"aggs": map[string]interface{}{
+					"cell_bounds": map[string]interface{}{
+						"geo_bounds": map[string]interface{}{
+							"field": "location",
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestGeoHashGridValidate(t *testing.T) {
+	assert.Nil(t, GeoHashGrid("grid", "location").Validate())
+	assert.Nil(t, GeoHashGrid("grid", "location").Precision(1).Validate())
+	assert.Nil(t, GeoHashGrid("grid", "location").Precision(12).Validate())
+	assert.NotNil(t, GeoHashGrid("grid", "location").Precision(0).Validate())
+	assert.NotNil(t, GeoHashGrid("grid", "location").Precision(20).Validate())
+
+	// out-of-range values are not altered
+	assert.Equal(
+		t,
+		uint8(20),
+		GeoHashGrid("grid", "location").Precision(20).Map()["geohash_grid"].(map[string]interface{})["precision"],
+	)
+}
@@ -13,10 +13,13 @@ type BaseAgg struct {
 // types.
 type BaseAggParams struct {
 	// Field is the name of the field to aggregate on.
-	Field string `structs:"field"`
+	Field string `structs:"field,omitempty"`
 	// Miss is a value to provide for documents that are missing a value for the
 	// field.
 	Miss interface{} `structs:"missing,omitempty"`
+	// Scr is the map representation of a script generating the values to
+	// aggregate, instead of (or in addition to) the field.
+	Scr map[string]interface{} `structs:"script,omitempty"`
 }
 
 func newBaseAgg(apiName, name, field string) *BaseAgg {
@@ -83,8 +86,9 @@ type WeightedAvgAgg struct {
 	Weig *BaseAggParams `structs:"weight"`
 }
 
-// WeightedAvg creates a new aggregation of type "weighted_agg" with the
-// provided name.
+// WeightedAvg creates a new aggregation of type "weighted_avg" with the
+// provided name. Both the value and the weight fields must be set, via Value
+// and Weight respectively.
 func WeightedAvg(name string) *WeightedAvgAgg {
 	return &WeightedAvgAgg{
 		name:    name,
@@ -108,7 +112,7 @@ func (agg *WeightedAvgAgg) Value(field string, missing ...interface{}) *Weighted
 	return agg
 }
 
-// Value sets the weight field and optionally a value to use when records are
+// Weight sets the weight field and optionally a value to use when records are
 // missing a value for the field.
 func (agg *WeightedAvgAgg) Weight(field string, missing ...interface{}) *WeightedAvgAgg {
 	agg.Weig = new(BaseAggParams)
@@ -155,7 +159,10 @@ func (agg *CardinalityAgg) Missing(val interface{}) *CardinalityAgg {
 	return agg
 }
 
-// PrecisionThreshold sets the precision threshold of the aggregation.
+// PrecisionThreshold sets the precision threshold of the aggregation. Counts
+// below the threshold are expected to be close to accurate, above it they may
+// be fuzzier. ElasticSearch defaults to 3000 and supports a maximum of 40000;
+// higher thresholds use more memory.
 func (agg *CardinalityAgg) PrecisionThreshold(val uint16) *CardinalityAgg {
 	agg.PrecisionThr = val
 	return agg
@@ -255,13 +262,28 @@ type ValueCountAgg struct {
 }
 
 // ValueCount creates a new aggregation of type "value_count", with the provided
-// name and on the provided field
+// name and on the provided field. Unlike Cardinality, it counts all values,
+// duplicates included.
 func ValueCount(name, field string) *ValueCountAgg {
 	return &ValueCountAgg{
 		BaseAgg: newBaseAgg("value_count", name, field),
 	}
 }
 
+// Missing sets the value to provide for documents missing a value for the
+// selected field.
+func (agg *ValueCountAgg) Missing(val interface{}) *ValueCountAgg {
+	agg.Miss = val
+	return agg
+}
+
+// Script sets a script generating the values to count. The script is converted
+// to its map representation when set.
+func (agg *ValueCountAgg) Script(script *Script) *ValueCountAgg {
+	agg.Scr = script.Map()
+	return agg
+}
+
 //----------------------------------------------------------------------------//
 
 // PercentilesAgg represents an aggregation of type "percentiles", as described
@@ -299,7 +321,8 @@ func Percentiles(name, field string) *PercentilesAgg {
 	}
 }
 
-// Percents sets the aggregation's percentages
+// Percents sets the aggregation's percentages. If not set, ElasticSearch
+// defaults to [1, 5, 25, 50, 75, 95, 99].
 func (agg *PercentilesAgg) Percents(percents ...float32) *PercentilesAgg {
 	agg.Prcnts = percents
 	return agg
@@ -341,6 +364,84 @@ func (agg *PercentilesAgg) Map() map[string]interface{} {
 
 //----------------------------------------------------------------------------//
 
+// PercentileRanksAgg represents an aggregation of type "percentile_ranks", as
+// described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-metrics-percentile-rank-aggregation.html
+type PercentileRanksAgg struct {
+	*BaseAgg `structs:",flatten"`
+
+	// Vals is the values to compute the percentile ranks of
+	Vals []float32 `structs:"values"`
+
+	// Key denotes whether the aggregation is keyed or not
+	Key *bool `structs:"keyed,omitempty"`
+
+	// TDigest includes options for the TDigest algorithm
+	TDigest struct {
+		// Compression is the compression level to use
+		Compression uint16 `structs:"compression,omitempty"`
+	} `structs:"tdigest,omitempty"`
+
+	// HDR includes options for the HDR implementation
+	HDR struct {
+		// NumHistogramDigits defines the resolution of values for the histogram
+		// in number of significant digits
+		NumHistogramDigits uint8 `structs:"number_of_significant_value_digits,omitempty"`
+	} `structs:"hdr,omitempty"`
+}
+
+// PercentileRanks creates a new aggregation of type "percentile_ranks" with
+// the provided name and on the provided field.
+func PercentileRanks(name, field string) *PercentileRanksAgg {
+	return &PercentileRanksAgg{
+		BaseAgg: newBaseAgg("percentile_ranks", name, field),
+	}
+}
+
+// Values sets the values to compute the percentile ranks of. ElasticSearch
+// requires at least one value.
+func (agg *PercentileRanksAgg) Values(values ...float32) *PercentileRanksAgg {
+	agg.Vals = values
+	return agg
+}
+
+// Missing sets the value to provide for records that are missing a value for
+// the field.
+func (agg *PercentileRanksAgg) Missing(val interface{}) *PercentileRanksAgg {
+	agg.Miss = val
+	return agg
+}
+
+// Keyed sets whether the aggregate is keyed or not.
+func (agg *PercentileRanksAgg) Keyed(b bool) *PercentileRanksAgg {
+	agg.Key = &b
+	return agg
+}
+
+// Compression sets the compression level for the aggregation.
+func (agg *PercentileRanksAgg) Compression(val uint16) *PercentileRanksAgg {
+	agg.TDigest.Compression = val
+	return agg
+}
+
+// NumHistogramDigits specifies the resolution of values for the histogram in
+// number of significant digits, enabling the HDR implementation.
+func (agg *PercentileRanksAgg) NumHistogramDigits(val uint8) *PercentileRanksAgg {
+	agg.HDR.NumHistogramDigits = val
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *PercentileRanksAgg) Map() map[string]interface{} {
+	return map[string]interface{}{
+		agg.apiName: structs.Map(agg),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
 // StatsAgg represents an aggregation of type "stats", as described in:
 // https://www.elastic.co/guide/en/elasticsearch/reference/
 //
@@ -363,6 +464,65 @@ func (agg *StatsAgg) Missing(val interface{}) *StatsAgg {
 	return agg
 }
 
+// Script sets a script generating the values to aggregate. The script is
+// converted to its map representation when set.
+func (agg *StatsAgg) Script(script *Script) *StatsAgg {
+	agg.Scr = script.Map()
+	return agg
+}
+
+// ---------------------------------------------------------------------------//
+
+// ExtendedStatsAgg represents an aggregation of type "extended_stats", as
+// described in: https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-metrics-extendedstats-aggregation.html
+type ExtendedStatsAgg struct {
+	*BaseAgg `structs:",flatten"`
+
+	// Sig is the number of standard deviations above and below the mean to
+	// return bounds for
+	Sig float64 `structs:"sigma,omitempty"`
+}
+
+// ExtendedStats creates a new "extended_stats" aggregation with the provided
+// name and on the provided field. On top of the statistics returned by the
+// "stats" aggregation, it returns the sum of squares, variance and standard
+// deviation of the values.
+func ExtendedStats(name, field string) *ExtendedStatsAgg {
+	return &ExtendedStatsAgg{
+		BaseAgg: newBaseAgg("extended_stats", name, field),
+	}
+}
+
+// Missing sets the value to provide for records missing a value for the field.
+func (agg *ExtendedStatsAgg) Missing(val interface{}) *ExtendedStatsAgg {
+	agg.Miss = val
+	return agg
+}
+
+// Script sets a script generating the values to aggregate. The script is
+// converted to its map representation when set.
+func (agg *ExtendedStatsAgg) Script(script *Script) *ExtendedStatsAgg {
+	agg.Scr = script.Map()
+	return agg
+}
+
+// Sigma sets the number of standard deviations above and below the mean to
+// return bounds for (ElasticSearch defaults to 2).
+func (agg *ExtendedStatsAgg) Sigma(sigma float64) *ExtendedStatsAgg {
+	agg.Sig = sigma
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *ExtendedStatsAgg) Map() map[string]interface{} {
+	return map[string]interface{}{
+		agg.apiName: structs.Map(agg),
+	}
+}
+
 // ---------------------------------------------------------------------------//
 
 // StringStatsAgg represents an aggregation of type "string_stats", as described
@@ -457,12 +617,28 @@ func (agg *TopHitsAgg) Sort(name string, order Order) *TopHitsAgg {
 	return agg
 }
 
+// SortBy adds sort options to the aggregation, e.g. ones created via the
+// SortBy or GeoDistanceSort functions.
+func (agg *TopHitsAgg) SortBy(sorts ...Mappable) *TopHitsAgg {
+	for _, s := range sorts {
+		agg.sort = append(agg.sort, s.Map())
+	}
+
+	return agg
+}
+
 // SourceIncludes sets the keys to return from the top matching documents.
 func (agg *TopHitsAgg) SourceIncludes(keys ...string) *TopHitsAgg {
 	agg.source.includes = keys
 	return agg
 }
 
+// SourceExcludes sets the keys not to return from the top matching documents.
+func (agg *TopHitsAgg) SourceExcludes(keys ...string) *TopHitsAgg {
+	agg.source.excludes = keys
+	return agg
+}
+
 // Map returns a map representation of the aggregation, thus implementing the
 // Mappable interface.
 func (agg *TopHitsAgg) Map() map[string]interface{} {
@@ -477,7 +653,7 @@ func (agg *TopHitsAgg) Map() map[string]interface{} {
 	if len(agg.sort) > 0 {
 		innerMap["sort"] = agg.sort
 	}
-	if len(agg.source.includes) > 0 {
+	if len(agg.source.includes) > 0 || len(agg.source.excludes) > 0 {
 		innerMap["_source"] = agg.source.Map()
 	}
 
@@ -485,3 +661,172 @@ func (agg *TopHitsAgg) Map() map[string]interface{} {
 		"top_hits": innerMap,
 	}
 }
+
+// ---------------------------------------------------------------------------//
+
+// ScriptedMetricAgg represents an aggregation of type "scripted_metric", as
+// described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-metrics-scripted-metric-aggregation.html
+type ScriptedMetricAgg struct {
+	name          string
+	initScript    interface{}
+	mapScript     interface{}
+	combineScript interface{}
+	reduceScript  interface{}
+	params        map[string]interface{}
+}
+
+// ScriptedMetric creates a new aggregation of type "scripted_metric" with the
+// provided name. Its result is computed by the scripts of its four phases: the
+// init, map and combine scripts run on every shard, and the reduce script
+// merges the results of all shards. The map, combine and reduce scripts are
+// required by ElasticSearch.
+//
+// Every script can either be a *Script, or a string containing the source of
+// the script.
+func ScriptedMetric(name string) *ScriptedMetricAgg {
+	return &ScriptedMetricAgg{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *ScriptedMetricAgg) Name() string {
+	return agg.name
+}
+
+// InitScript sets the script executed before documents are collected, which
+// usually initializes the "state" object.
+func (agg *ScriptedMetricAgg) InitScript(script interface{}) *ScriptedMetricAgg {
+	agg.initScript = script
+	return agg
+}
+
+// MapScript sets the script executed once per collected document.
+func (agg *ScriptedMetricAgg) MapScript(script interface{}) *ScriptedMetricAgg {
+	agg.mapScript = script
+	return agg
+}
+
+// CombineScript sets the script executed once per shard after documents are
+// collected, which returns the result of the shard.
+func (agg *ScriptedMetricAgg) CombineScript(script interface{}) *ScriptedMetricAgg {
+	agg.combineScript = script
+	return agg
+}
+
+// ReduceScript sets the script merging the results of all shards, available
+// to it as the "states" variable.
+func (agg *ScriptedMetricAgg) ReduceScript(script interface{}) *ScriptedMetricAgg {
+	agg.reduceScript = script
+	return agg
+}
+
+// Params sets parameters available to all scripts as the "params" variable.
+func (agg *ScriptedMetricAgg) Params(params map[string]interface{}) *ScriptedMetricAgg {
+	agg.params = params
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *ScriptedMetricAgg) Map() map[string]interface{} {
+	innerMap := make(map[string]interface{})
+	for key, script := range map[string]interface{}{
+		"init_script":    agg.initScript,
+		"map_script":     agg.mapScript,
+		"combine_script": agg.combineScript,
+		"reduce_script":  agg.reduceScript,
+	} {
+		switch s := script.(type) {
+		case nil:
+		case Mappable:
+			innerMap[key] = s.Map()
+		default:
+			innerMap[key] = s
+		}
+	}
+	if len(agg.params) > 0 {
+		innerMap["params"] = agg.params
+	}
+
+	return map[string]interface{}{
+		"scripted_metric": innerMap,
+	}
+}
+
+// ---------------------------------------------------------------------------//
+
+// TopMetricsAgg represents an aggregation of type "top_metrics", as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-metrics-top-metrics.html
+type TopMetricsAgg struct {
+	name    string
+	metrics []string
+	size    uint64
+	sort    []map[string]interface{}
+}
+
+// TopMetrics creates an aggregation of type "top_metrics". It is a lighter
+// alternative to top_hits, returning only the values of the fields set via the
+// Metrics method for the documents sorted first.
+func TopMetrics(name string) *TopMetricsAgg {
+	return &TopMetricsAgg{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *TopMetricsAgg) Name() string {
+	return agg.name
+}
+
+// Metrics adds fields whose values are returned for the top documents.
+func (agg *TopMetricsAgg) Metrics(fields ...string) *TopMetricsAgg {
+	agg.metrics = append(agg.metrics, fields...)
+	return agg
+}
+
+// Size sets the number of top documents to return metrics for (the default is
+// 1).
+func (agg *TopMetricsAgg) Size(size uint64) *TopMetricsAgg {
+	agg.size = size
+	return agg
+}
+
+// Sort adds sort options determining the top documents, e.g. ones created via
+// the SortBy or GeoDistanceSort functions.
+func (agg *TopMetricsAgg) Sort(sorts ...Mappable) *TopMetricsAgg {
+	for _, s := range sorts {
+		agg.sort = append(agg.sort, s.Map())
+	}
+
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *TopMetricsAgg) Map() map[string]interface{} {
+	metrics := make([]map[string]interface{}, len(agg.metrics))
+	for i, field := range agg.metrics {
+		metrics[i] = map[string]interface{}{
+			"field": field,
+		}
+	}
+
+	innerMap := map[string]interface{}{
+		"metrics": metrics,
+	}
+	if agg.size > 0 {
+		innerMap["size"] = agg.size
+	}
+	if len(agg.sort) > 0 {
+		innerMap["sort"] = agg.sort
+	}
+
+	return map[string]interface{}{
+		"top_metrics": innerMap,
+	}
+}
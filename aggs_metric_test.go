@@ -38,6 +38,57 @@ func TestMetricAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"weighted avg with missing values for both fields",
+			TermsAgg("products", "product_id").Aggs(
+				WeightedAvg("weighted_rating").Value("rating", 3.0).Weight("votes", 1.0),
+			),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "product_id",
+				},
+				"aggs": map[string]interface{}{
+					"weighted_rating": map[string]interface{}{
+						"weighted_avg": map[string]interface{}{
+							"value": map[string]interface{}{
+								"field":   "rating",
+								"missing": 3,
+							},
+							"weight": map[string]interface{}{
+								"field":   "votes",
+								"missing": 1,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"scripted_metric with string and script phases",
+			ScriptedMetric("profit").
+				InitScript("state.transactions = []").
+				MapScript(ScriptSource(
+					"state.transactions.add(doc.type.value == 'sale' ? doc.amount.value : -1 * doc.amount.value)",
+				)).
+				CombineScript("double profit = 0; for (t in state.transactions) { profit += t } return profit").
+				ReduceScript(ScriptID("sum_states")).
+				Params(map[string]interface{}{"currency": "EUR"}),
+			map[string]interface{}{
+				"scripted_metric": map[string]interface{}{
+					"init_script": "state.transactions = []",
+					"map_script": map[string]interface{}{
+						"source": "state.transactions.add(doc.type.value == 'sale' ? doc.amount.value : -1 * doc.amount.value)",
+					},
+					"combine_script": "double profit = 0; for (t in state.transactions) { profit += t } return profit",
+					"reduce_script": map[string]interface{}{
+						"id": "sum_states",
+					},
+					"params": map[string]interface{}{
+						"currency": "EUR",
+					},
+				},
+			},
+		},
 		{
 			"cardinality: no precision threshold",
 			Cardinality("type_count", "type"),
@@ -57,6 +108,19 @@ func TestMetricAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"cardinality: with missing value",
+			Cardinality("user_count", "user_id").
+				PrecisionThreshold(40000).
+				Missing("anonymous"),
+			map[string]interface{}{
+				"cardinality": map[string]interface{}{
+					"field":               "user_id",
+					"precision_threshold": 40000,
+					"missing":             "anonymous",
+				},
+			},
+		},
 		{
 			"value_count agg: simple",
 			ValueCount("num_values", "score"),
@@ -66,6 +130,17 @@ func TestMetricAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"value_count agg: with script",
+			ValueCount("num_values", "").Script(ScriptSource("doc['score'].value")),
+			map[string]interface{}{
+				"value_count": map[string]interface{}{
+					"script": map[string]interface{}{
+						"source": "doc['score'].value",
+					},
+				},
+			},
+		},
 		{
 			"sum agg: simple",
 			Sum("total_score", "score").Missing(1),
@@ -126,6 +201,23 @@ func TestMetricAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"percentile_ranks: with hdr",
+			PercentileRanks("load_time_ranks", "load_time").
+				Values(500, 600).
+				Keyed(false).
+				NumHistogramDigits(3),
+			map[string]interface{}{
+				"percentile_ranks": map[string]interface{}{
+					"field":  "load_time",
+					"values": []float32{500, 600},
+					"keyed":  false,
+					"hdr": map[string]interface{}{
+						"number_of_significant_value_digits": 3,
+					},
+				},
+			},
+		},
 		{
 			"stats agg",
 			Stats("grades_stats", "grade"),
@@ -135,6 +227,49 @@ func TestMetricAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"stats agg with script and missing",
+			Stats("grades_stats", "grade").
+				Missing(0).
+				Script(ScriptSource("_value * params.correction").Params(map[string]interface{}{
+					"correction": 1.2,
+				})),
+			map[string]interface{}{
+				"stats": map[string]interface{}{
+					"field":   "grade",
+					"missing": 0,
+					"script": map[string]interface{}{
+						"source": "_value * params.correction",
+						"params": map[string]interface{}{
+							"correction": 1.2,
+						},
+					},
+				},
+			},
+		},
+		{
+			"extended_stats agg",
+			ExtendedStats("grades_stats", "grade").Sigma(3).Missing(0),
+			map[string]interface{}{
+				"extended_stats": map[string]interface{}{
+					"field":   "grade",
+					"missing": 0,
+					"sigma":   3,
+				},
+			},
+		},
+		{
+			"extended_stats agg with script only",
+			ExtendedStats("grades_stats", "").Script(ScriptSource("doc.grade.value").Lang("painless")),
+			map[string]interface{}{
+				"extended_stats": map[string]interface{}{
+					"script": map[string]interface{}{
+						"source": "doc.grade.value",
+						"lang":   "painless",
+					},
+				},
+			},
+		},
 		{
 			"string_stats agg: no show distribution",
 			StringStats("message_stats", "message.keyword"),
@@ -154,5 +289,92 @@ func TestMetricAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"top_hits: newest item per category",
+			TermsAgg("categories", "category").
+				Aggs(
+					TopHits("newest").
+						Size(1).
+						SortBy(SortBy("published_at").Order(OrderDesc)).
+						SourceIncludes("title", "published_at").
+						SourceExcludes("body"),
+				),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "category",
+				},
+				"aggs": map[string]interface{}{
+					"newest": map[string]interface{}{
+						"top_hits": map[string]interface{}{
+							"size": 1,
+							"sort": []map[string]interface{}{
+								{
+									"published_at": map[string]interface{}{
+										"order": "desc",
+									},
+								},
+							},
+							"_source": map[string]interface{}{
+								"includes": []string{"title", "published_at"},
+								"excludes": []string{"body"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"top_metrics: latest price per product",
+			TermsAgg("products", "product_id").
+				Aggs(
+					TopMetrics("latest_price").
+						Metrics("price", "currency").
+						Sort(SortBy("@timestamp").Order(OrderDesc)),
+				),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "product_id",
+				},
+				"aggs": map[string]interface{}{
+					"latest_price": map[string]interface{}{
+						"top_metrics": map[string]interface{}{
+							"metrics": []map[string]interface{}{
+								{"field": "price"},
+								{"field": "currency"},
+							},
+							"sort": []map[string]interface{}{
+								{
+									"@timestamp": map[string]interface{}{
+										"order": "desc",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"top_metrics: with size and multiple sorts",
+			TopMetrics("tm").Metrics("m").Size(3).Sort(SortBy("s").Order(OrderAsc), SortBy("t")),
+			map[string]interface{}{
+				"top_metrics": map[string]interface{}{
+					"metrics": []map[string]interface{}{
+						{"field": "m"},
+					},
+					"size": 3,
+					"sort": []map[string]interface{}{
+						{
+							"s": map[string]interface{}{
+								"order": "asc",
+							},
+						},
+						{
+							"t": map[string]interface{}{},
+						},
+					},
+				},
+			},
+		},
 	})
 }
@@ -1,5 +1,9 @@
 package elasticsearch
 
+// NestedAggregation represents an aggregation of type "nested", as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-nested-aggregation.html
 type NestedAggregation struct {
 	name string
 	path string
@@ -20,7 +24,7 @@ func (agg *NestedAggregation) Name() string {
 	return agg.name
 }
 
-// NumberOfFragments sets the aggregations path
+// Path sets the path of the nested documents to aggregate on.
 func (agg *NestedAggregation) Path(p string) *NestedAggregation {
 	agg.path = p
 	return agg
@@ -32,6 +36,8 @@ func (agg *NestedAggregation) Aggs(aggs ...Aggregation) *NestedAggregation {
 	return agg
 }
 
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
 func (agg *NestedAggregation) Map() map[string]interface{} {
 	innerMap := map[string]interface{}{
 		"path": agg.path,
@@ -42,11 +48,68 @@ func (agg *NestedAggregation) Map() map[string]interface{} {
 	}
 
 	if len(agg.aggs) > 0 {
-		subAggs := make(map[string]map[string]interface{})
-		for _, sub := range agg.aggs {
-			subAggs[sub.Name()] = sub.Map()
-		}
-		outerMap["aggs"] = subAggs
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// ReverseNestedAggregation represents an aggregation of type "reverse_nested",
+// as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-reverse-nested-aggregation.html
+type ReverseNestedAggregation struct {
+	name string
+	path string
+	aggs []Aggregation
+}
+
+// ReverseNested creates a new aggregation of type "reverse_nested" with the
+// provided name. It must be used inside a nested aggregation, and joins back
+// from the nested documents to their root documents (or to the nested
+// documents at the path set via Path), so that its sub-aggregations run on
+// the parent documents.
+func ReverseNested(name string) *ReverseNestedAggregation {
+	return &ReverseNestedAggregation{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *ReverseNestedAggregation) Name() string {
+	return agg.name
+}
+
+// Path sets the path of the nested documents to join back to. By default, the
+// aggregation joins back to the root documents.
+func (agg *ReverseNestedAggregation) Path(p string) *ReverseNestedAggregation {
+	agg.path = p
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *ReverseNestedAggregation) Aggs(aggs ...Aggregation) *ReverseNestedAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *ReverseNestedAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{}
+	if agg.path != "" {
+		innerMap["path"] = agg.path
+	}
+
+	outerMap := map[string]interface{}{
+		"reverse_nested": innerMap,
+	}
+
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
 	}
 
 	return outerMap
@@ -30,5 +30,48 @@ func TestNestedAggs(t *testing.T) {
 				},
 			},
 		},
+		{
+			"reverse_nested agg: with path",
+			ReverseNested("to_offers").Path("offers"),
+			map[string]interface{}{
+				"reverse_nested": map[string]interface{}{
+					"path": "offers",
+				},
+			},
+		},
+		{
+			"nested agg: back to the root documents",
+			NestedAgg("offers", "offers").Aggs(
+				TermsAgg("colors", "offers.color").Aggs(
+					ReverseNested("products").Aggs(
+						Cardinality("product_count", "product_id"),
+					),
+				),
+			),
+			map[string]interface{}{
+				"nested": map[string]interface{}{
+					"path": "offers",
+				},
+				"aggs": map[string]interface{}{
+					"colors": map[string]interface{}{
+						"terms": map[string]interface{}{
+							"field": "offers.color",
+						},
+						"aggs": map[string]interface{}{
+							"products": map[string]interface{}{
+								"reverse_nested": map[string]interface{}{},
+								"aggs": map[string]interface{}{
+									"product_count": map[string]interface{}{
+										"cardinality": map[string]interface{}{
+											"field": "product_id",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	})
 }
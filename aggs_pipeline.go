@@ -0,0 +1,529 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+// GapPolicy is an enumeration of the policies pipeline aggregations can use
+// when data is missing in a bucket.
+type GapPolicy uint8
+
+const (
+	_ GapPolicy = iota
+
+	// GapPolicySkip represents the "skip" gap policy
+	GapPolicySkip
+
+	// GapPolicyInsertZeros represents the "insert_zeros" gap policy
+	GapPolicyInsertZeros
+
+	// GapPolicyKeepValues represents the "keep_values" gap policy
+	GapPolicyKeepValues
+)
+
+// String returns a string representation of the gap policy, as known to
+// ElasticSearch.
+func (p GapPolicy) String() string {
+	switch p {
+	case GapPolicySkip:
+		return "skip"
+	case GapPolicyInsertZeros:
+		return "insert_zeros"
+	case GapPolicyKeepValues:
+		return "keep_values"
+	}
+	return ""
+}
+
+// BasePipelineAgg contains several fields that are common for all pipeline
+// aggregation types.
+type BasePipelineAgg struct {
+	name               string
+	apiName            string
+	*PipelineAggParams `structs:",flatten"`
+}
+
+// PipelineAggParams contains fields that are common to most pipeline
+// aggregation types.
+type PipelineAggParams struct {
+	// BucketsPath is the path to the buckets the aggregation operates on, e.g.
+	// "sales_per_month>sales".
+	BucketsPath string `structs:"buckets_path"`
+	// GapPol is the policy to apply when gaps are found in the data.
+	GapPol GapPolicy `structs:"gap_policy,string,omitempty"`
+	// Fmt is the format to apply to the output value of the aggregation.
+	Fmt string `structs:"format,omitempty"`
+}
+
+func newBasePipelineAgg(apiName, name, bucketsPath string) *BasePipelineAgg {
+	return &BasePipelineAgg{
+		name:    name,
+		apiName: apiName,
+		PipelineAggParams: &PipelineAggParams{
+			BucketsPath: bucketsPath,
+		},
+	}
+}
+
+// Name returns the name of the aggregation, allowing implementation of the
+// Aggregation interface.
+func (agg *BasePipelineAgg) Name() string {
+	return agg.name
+}
+
+// Map returns a map representation of the aggregation, implementing the
+// Mappable interface.
+func (agg *BasePipelineAgg) Map() map[string]interface{} {
+	return map[string]interface{}{
+		agg.apiName: structs.Map(agg.PipelineAggParams),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// AvgBucketAgg represents a sibling pipeline aggregation of type "avg_bucket",
+// as described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-avg-bucket-aggregation.html
+type AvgBucketAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+}
+
+// AvgBucket creates a new aggregation of type "avg_bucket", with the provided
+// name and buckets path.
+func AvgBucket(name, bucketsPath string) *AvgBucketAgg {
+	return &AvgBucketAgg{
+		BasePipelineAgg: newBasePipelineAgg("avg_bucket", name, bucketsPath),
+	}
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *AvgBucketAgg) GapPolicy(p GapPolicy) *AvgBucketAgg {
+	agg.GapPol = p
+	return agg
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *AvgBucketAgg) Format(f string) *AvgBucketAgg {
+	agg.Fmt = f
+	return agg
+}
+
+//----------------------------------------------------------------------------//
+
+// SumBucketAgg represents a sibling pipeline aggregation of type "sum_bucket",
+// as described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-sum-bucket-aggregation.html
+type SumBucketAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+}
+
+// SumBucket creates a new aggregation of type "sum_bucket", with the provided
+// name and buckets path.
+func SumBucket(name, bucketsPath string) *SumBucketAgg {
+	return &SumBucketAgg{
+		BasePipelineAgg: newBasePipelineAgg("sum_bucket", name, bucketsPath),
+	}
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *SumBucketAgg) GapPolicy(p GapPolicy) *SumBucketAgg {
+	agg.GapPol = p
+	return agg
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *SumBucketAgg) Format(f string) *SumBucketAgg {
+	agg.Fmt = f
+	return agg
+}
+
+//----------------------------------------------------------------------------//
+
+// MinBucketAgg represents a sibling pipeline aggregation of type "min_bucket",
+// as described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-min-bucket-aggregation.html
+type MinBucketAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+}
+
+// MinBucket creates a new aggregation of type "min_bucket", with the provided
+// name and buckets path.
+func MinBucket(name, bucketsPath string) *MinBucketAgg {
+	return &MinBucketAgg{
+		BasePipelineAgg: newBasePipelineAgg("min_bucket", name, bucketsPath),
+	}
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *MinBucketAgg) GapPolicy(p GapPolicy) *MinBucketAgg {
+	agg.GapPol = p
+	return agg
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *MinBucketAgg) Format(f string) *MinBucketAgg {
+	agg.Fmt = f
+	return agg
+}
+
+//----------------------------------------------------------------------------//
+
+// MaxBucketAgg represents a sibling pipeline aggregation of type "max_bucket",
+// as described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-max-bucket-aggregation.html
+type MaxBucketAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+}
+
+// MaxBucket creates a new aggregation of type "max_bucket", with the provided
+// name and buckets path.
+func MaxBucket(name, bucketsPath string) *MaxBucketAgg {
+	return &MaxBucketAgg{
+		BasePipelineAgg: newBasePipelineAgg("max_bucket", name, bucketsPath),
+	}
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *MaxBucketAgg) GapPolicy(p GapPolicy) *MaxBucketAgg {
+	agg.GapPol = p
+	return agg
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *MaxBucketAgg) Format(f string) *MaxBucketAgg {
+	agg.Fmt = f
+	return agg
+}
+
+//----------------------------------------------------------------------------//
+
+// StatsBucketAgg represents a sibling pipeline aggregation of type
+// "stats_bucket", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-stats-bucket-aggregation.html
+type StatsBucketAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+}
+
+// StatsBucket creates a new aggregation of type "stats_bucket", with the
+// provided name and buckets path.
+func StatsBucket(name, bucketsPath string) *StatsBucketAgg {
+	return &StatsBucketAgg{
+		BasePipelineAgg: newBasePipelineAgg("stats_bucket", name, bucketsPath),
+	}
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *StatsBucketAgg) GapPolicy(p GapPolicy) *StatsBucketAgg {
+	agg.GapPol = p
+	return agg
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *StatsBucketAgg) Format(f string) *StatsBucketAgg {
+	agg.Fmt = f
+	return agg
+}
+
+//----------------------------------------------------------------------------//
+
+// DerivativeAgg represents a parent pipeline aggregation of type "derivative",
+// as described in https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-derivative-aggregation.html
+//
+// It must be placed as a sub-aggregation of a histogram or date_histogram,
+// alongside the metric it references.
+type DerivativeAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+
+	// Unt is the unit used to normalize the derivative, e.g. "1d".
+	Unt string `structs:"unit,omitempty"`
+}
+
+// Derivative creates a new aggregation of type "derivative", with the provided
+// name and buckets path.
+func Derivative(name, bucketsPath string) *DerivativeAgg {
+	return &DerivativeAgg{
+		BasePipelineAgg: newBasePipelineAgg("derivative", name, bucketsPath),
+	}
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *DerivativeAgg) GapPolicy(p GapPolicy) *DerivativeAgg {
+	agg.GapPol = p
+	return agg
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *DerivativeAgg) Format(f string) *DerivativeAgg {
+	agg.Fmt = f
+	return agg
+}
+
+// Unit sets the unit to normalize the derivative to, e.g. "1d" for a per-day
+// derivative. ElasticSearch then returns a "normalized_value" in each bucket.
+func (agg *DerivativeAgg) Unit(unit string) *DerivativeAgg {
+	agg.Unt = unit
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *DerivativeAgg) Map() map[string]interface{} {
+	return map[string]interface{}{
+		agg.apiName: structs.Map(agg),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// BucketScriptAgg represents a parent pipeline aggregation of type
+// "bucket_script", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-bucket-script-aggregation.html
+//
+// It must be placed as a sub-aggregation of a multi-bucket aggregation,
+// alongside the metrics it references.
+type BucketScriptAgg struct {
+	name        string
+	bucketsPath map[string]string
+	script      *Script
+	gapPolicy   GapPolicy
+	format      string
+}
+
+// BucketScript creates a new aggregation of type "bucket_script", with the
+// provided name. It computes a value for every bucket of its parent
+// aggregation by running a script over the metrics referenced via BucketsPath.
+func BucketScript(name string) *BucketScriptAgg {
+	return &BucketScriptAgg{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation, allowing implementation of the
+// Aggregation interface.
+func (agg *BucketScriptAgg) Name() string {
+	return agg.name
+}
+
+// BucketsPath sets the metrics the script operates on, as a map of script
+// variable names to buckets paths. The variables are available to the script
+// via "params", e.g. "params.total / params.count".
+func (agg *BucketScriptAgg) BucketsPath(paths map[string]string) *BucketScriptAgg {
+	agg.bucketsPath = paths
+	return agg
+}
+
+// Script sets the script computing the value of each bucket.
+func (agg *BucketScriptAgg) Script(script *Script) *BucketScriptAgg {
+	agg.script = script
+	return agg
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *BucketScriptAgg) GapPolicy(p GapPolicy) *BucketScriptAgg {
+	agg.gapPolicy = p
+	return agg
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *BucketScriptAgg) Format(f string) *BucketScriptAgg {
+	agg.format = f
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *BucketScriptAgg) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"buckets_path": agg.bucketsPath,
+	}
+	if agg.script != nil {
+		innerMap["script"] = agg.script.Map()
+	}
+	if agg.gapPolicy != 0 {
+		innerMap["gap_policy"] = agg.gapPolicy.String()
+	}
+	if agg.format != "" {
+		innerMap["format"] = agg.format
+	}
+
+	return map[string]interface{}{
+		"bucket_script": innerMap,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// BucketSelectorAgg represents a parent pipeline aggregation of type
+// "bucket_selector", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-bucket-selector-aggregation.html
+//
+// It must be placed as a sub-aggregation of a multi-bucket aggregation,
+// alongside the metrics it references.
+type BucketSelectorAgg struct {
+	name        string
+	bucketsPath map[string]string
+	script      *Script
+	gapPolicy   GapPolicy
+}
+
+// BucketSelector creates a new aggregation of type "bucket_selector", with the
+// provided name. It removes the buckets of its parent aggregation for which
+// the script, run over the metrics referenced via BucketsPath, returns false,
+// similarly to a SQL HAVING clause.
+func BucketSelector(name string) *BucketSelectorAgg {
+	return &BucketSelectorAgg{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation, allowing implementation of the
+// Aggregation interface.
+func (agg *BucketSelectorAgg) Name() string {
+	return agg.name
+}
+
+// BucketsPath sets the metrics the script operates on, as a map of script
+// variable names to buckets paths. The variables are available to the script
+// via "params", e.g. "params.count > 100".
+func (agg *BucketSelectorAgg) BucketsPath(paths map[string]string) *BucketSelectorAgg {
+	agg.bucketsPath = paths
+	return agg
+}
+
+// Script sets the script deciding whether each bucket is kept. It must return a
+// boolean.
+func (agg *BucketSelectorAgg) Script(script *Script) *BucketSelectorAgg {
+	agg.script = script
+	return agg
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *BucketSelectorAgg) GapPolicy(p GapPolicy) *BucketSelectorAgg {
+	agg.gapPolicy = p
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *BucketSelectorAgg) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"buckets_path": agg.bucketsPath,
+	}
+	if agg.script != nil {
+		innerMap["script"] = agg.script.Map()
+	}
+	if agg.gapPolicy != 0 {
+		innerMap["gap_policy"] = agg.gapPolicy.String()
+	}
+
+	return map[string]interface{}{
+		"bucket_selector": innerMap,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// MovingFunctionAgg represents a parent pipeline aggregation of type
+// "moving_fn", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-movfn-aggregation.html
+//
+// It must be placed as a sub-aggregation of a histogram or date_histogram,
+// alongside the metric it references.
+type MovingFunctionAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+
+	// Win is the number of buckets in the sliding window.
+	Win uint16 `structs:"window"`
+
+	// Scr is the script executed over each window.
+	Scr string `structs:"script"`
+
+	// Shft shifts the position of the window.
+	Shft int `structs:"shift,omitempty"`
+}
+
+// MovingFunction creates a new aggregation of type "moving_fn", with the
+// provided name and buckets path. It slides a window over the buckets of its
+// parent histogram and runs a script over the values of each window, usually
+// one of the built-in functions such as "MovingFunctions.unweightedAvg(values)".
+func MovingFunction(name, bucketsPath string) *MovingFunctionAgg {
+	return &MovingFunctionAgg{
+		BasePipelineAgg: newBasePipelineAgg("moving_fn", name, bucketsPath),
+	}
+}
+
+// Window sets the number of buckets in the sliding window.
+func (agg *MovingFunctionAgg) Window(size uint16) *MovingFunctionAgg {
+	agg.Win = size
+	return agg
+}
+
+// Script sets the script executed over each window. The values of the window
+// are available to the script as "values".
+func (agg *MovingFunctionAgg) Script(script string) *MovingFunctionAgg {
+	agg.Scr = script
+	return agg
+}
+
+// Shift shifts the position of the window. By default, the window includes the
+// buckets preceding the current one, but not the current one; a shift of 1
+// includes it.
+func (agg *MovingFunctionAgg) Shift(shift int) *MovingFunctionAgg {
+	agg.Shft = shift
+	return agg
+}
+
+// GapPolicy sets the policy to apply when gaps are found in the data.
+func (agg *MovingFunctionAgg) GapPolicy(p GapPolicy) *MovingFunctionAgg {
+	agg.GapPol = p
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *MovingFunctionAgg) Map() map[string]interface{} {
+	return map[string]interface{}{
+		agg.apiName: structs.Map(agg),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// CumulativeSumAgg represents a parent pipeline aggregation of type
+// "cumulative_sum", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/
+//
+//	current/search-aggregations-pipeline-cumulative-sum-aggregation.html
+//
+// It must be placed as a sub-aggregation of a histogram or date_histogram,
+// alongside the metric it references.
+type CumulativeSumAgg struct {
+	*BasePipelineAgg `structs:",flatten"`
+}
+
+// CumulativeSum creates a new aggregation of type "cumulative_sum", with the
+// provided name and buckets path. It returns the running total of the
+// referenced metric in every bucket of its parent histogram.
+func CumulativeSum(name, bucketsPath string) *CumulativeSumAgg {
+	return &CumulativeSumAgg{
+		BasePipelineAgg: newBasePipelineAgg("cumulative_sum", name, bucketsPath),
+	}
+}
+
+// Format sets the format to apply to the output value of the aggregation.
+func (agg *CumulativeSumAgg) Format(f string) *CumulativeSumAgg {
+	agg.Fmt = f
+	return agg
+}
@@ -0,0 +1,248 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestPipelineAggs(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"max_bucket as a sibling of a date_histogram",
+			Aggregate(
+				DateHistogram("sales_per_month", "date").
+					CalendarInterval("month").
+					Aggs(Sum("sales", "price")),
+				MaxBucket("max_monthly_sales", "sales_per_month>sales"),
+			),
+			map[string]interface{}{
+				"aggs": map[string]interface{}{
+					"sales_per_month": map[string]interface{}{
+						"date_histogram": map[string]interface{}{
+							"field":             "date",
+							"calendar_interval": "month",
+						},
+						"aggs": map[string]interface{}{
+							"sales": map[string]interface{}{
+								"sum": map[string]interface{}{
+									"field": "price",
+								},
+							},
+						},
+					},
+					"max_monthly_sales": map[string]interface{}{
+						"max_bucket": map[string]interface{}{
+							"buckets_path": "sales_per_month>sales",
+						},
+					},
+				},
+			},
+		},
+		{
+			"all bucket pipelines with options",
+			Aggregate(
+				AvgBucket("avg", "a>b").GapPolicy(GapPolicySkip),
+				SumBucket("sum", "a>b").Format("0.00"),
+				MinBucket("min", "a>b").GapPolicy(GapPolicyInsertZeros),
+				StatsBucket("stats", "a>b").
+					GapPolicy(GapPolicyKeepValues).
+					Format("#"),
+			),
+			map[string]interface{}{
+				"aggs": map[string]interface{}{
+					"avg": map[string]interface{}{
+						"avg_bucket": map[string]interface{}{
+							"buckets_path": "a>b",
+							"gap_policy":   "skip",
+						},
+					},
+					"sum": map[string]interface{}{
+						"sum_bucket": map[string]interface{}{
+							"buckets_path": "a>b",
+							"format":       "0.00",
+						},
+					},
+					"min": map[string]interface{}{
+						"min_bucket": map[string]interface{}{
+							"buckets_path": "a>b",
+							"gap_policy":   "insert_zeros",
+						},
+					},
+					"stats": map[string]interface{}{
+						"stats_bucket": map[string]interface{}{
+							"buckets_path": "a>b",
+							"gap_policy":   "keep_values",
+							"format":       "#",
+						},
+					},
+				},
+			},
+		},
+		{
+			"derivative of a nested sum in a date_histogram",
+			DateHistogram("sales_per_month", "date").
+				CalendarInterval("month").
+				Aggs(
+					Sum("sales", "price"),
+					Derivative("sales_deriv", "sales").
+						GapPolicy(GapPolicyInsertZeros).
+						Unit("1d"),
+				),
+			map[string]interface{}{
+				"date_histogram": map[string]interface{}{
+					"field":             "date",
+					"calendar_interval": "month",
+				},
+				"aggs": map[string]interface{}{
+					"sales": map[string]interface{}{
+						"sum": map[string]interface{}{
+							"field": "price",
+						},
+					},
+					"sales_deriv": map[string]interface{}{
+						"derivative": map[string]interface{}{
+							"buckets_path": "sales",
+							"gap_policy":   "insert_zeros",
+							"unit":         "1d",
+						},
+					},
+				},
+			},
+		},
+		{
+			"bucket_script computing a ratio per bucket",
+			TermsAgg("campaigns", "campaign").Aggs(
+				Sum("total", "conversions"),
+				ValueCount("count", "visit_id"),
+				BucketScript("conversion_rate").
+					BucketsPath(map[string]string{"total": "total", "count": "count"}).
+					Script(ScriptSource("params.total / params.count")).
+					GapPolicy(GapPolicySkip).
+					Format("0.00"),
+			),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "campaign",
+				},
+				"aggs": map[string]interface{}{
+					"total": map[string]interface{}{
+						"sum": map[string]interface{}{
+							"field": "conversions",
+						},
+					},
+					"count": map[string]interface{}{
+						"value_count": map[string]interface{}{
+							"field": "visit_id",
+						},
+					},
+					"conversion_rate": map[string]interface{}{
+						"bucket_script": map[string]interface{}{
+							"buckets_path": map[string]interface{}{
+								"total": "total",
+								"count": "count",
+							},
+							"script": map[string]interface{}{
+								"source": "params.total / params.count",
+							},
+							"gap_policy": "skip",
+							"format":     "0.00",
+						},
+					},
+				},
+			},
+		},
+		{
+			"bucket_selector keeping buckets with many documents",
+			TermsAgg("users", "user_id").Aggs(
+				ValueCount("orders", "order_id"),
+				BucketSelector("frequent_buyers").
+					BucketsPath(map[string]string{"count": "orders"}).
+					Script(ScriptSource("params.count > 100")),
+			),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": "user_id",
+				},
+				"aggs": map[string]interface{}{
+					"orders": map[string]interface{}{
+						"value_count": map[string]interface{}{
+							"field": "order_id",
+						},
+					},
+					"frequent_buyers": map[string]interface{}{
+						"bucket_selector": map[string]interface{}{
+							"buckets_path": map[string]interface{}{
+								"count": "orders",
+							},
+							"script": map[string]interface{}{
+								"source": "params.count > 100",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"moving_fn over date buckets",
+			DateHistogram("sales_per_day", "date").
+				CalendarInterval("day").
+				Aggs(
+					Sum("sales", "price"),
+					MovingFunction("sales_avg", "sales").
+						Window(10).
+						Script("MovingFunctions.unweightedAvg(values)").
+						Shift(1).
+						GapPolicy(GapPolicyKeepValues),
+				),
+			map[string]interface{}{
+				"date_histogram": map[string]interface{}{
+					"field":             "date",
+					"calendar_interval": "day",
+				},
+				"aggs": map[string]interface{}{
+					"sales": map[string]interface{}{
+						"sum": map[string]interface{}{
+							"field": "price",
+						},
+					},
+					"sales_avg": map[string]interface{}{
+						"moving_fn": map[string]interface{}{
+							"buckets_path": "sales",
+							"window":       10,
+							"script":       "MovingFunctions.unweightedAvg(values)",
+							"shift":        1,
+							"gap_policy":   "keep_values",
+						},
+					},
+				},
+			},
+		},
+		{
+			"cumulative_sum over date buckets",
+			DateHistogram("sales_per_month", "date").
+				CalendarInterval("month").
+				Aggs(
+					Sum("sales", "price"),
+					CumulativeSum("cumulative_sales", "sales").Format("#,##0"),
+				),
+			map[string]interface{}{
+				"date_histogram": map[string]interface{}{
+					"field":             "date",
+					"calendar_interval": "month",
+				},
+				"aggs": map[string]interface{}{
+					"sales": map[string]interface{}{
+						"sum": map[string]interface{}{
+							"field": "price",
+						},
+					},
+					"cumulative_sales": map[string]interface{}{
+						"cumulative_sum": map[string]interface{}{
+							"buckets_path": "sales",
+							"format":       "#,##0",
+						},
+					},
+				},
+			},
+		},
+	})
+}
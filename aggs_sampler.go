@@ -0,0 +1,142 @@
+package elasticsearch
+
+// SamplerAggregation represents an aggregation of type "sampler", as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-sampler-aggregation.html
+type SamplerAggregation struct {
+	name      string
+	shardSize *uint64
+	aggs      []Aggregation
+}
+
+// Sampler creates a new aggregation of type "sampler" with the provided name.
+// It restricts its sub-aggregations to the top-scoring documents of each shard,
+// which is useful to speed up expensive aggregations such as
+// significant_terms.
+func Sampler(name string) *SamplerAggregation {
+	return &SamplerAggregation{
+		name: name,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *SamplerAggregation) Name() string {
+	return agg.name
+}
+
+// ShardSize sets the number of top-scoring documents sampled from each shard
+// (ElasticSearch defaults to 100).
+func (agg *SamplerAggregation) ShardSize(size uint64) *SamplerAggregation {
+	agg.shardSize = &size
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *SamplerAggregation) Aggs(aggs ...Aggregation) *SamplerAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *SamplerAggregation) Map() map[string]interface{} {
+	innerMap := make(map[string]interface{})
+	if agg.shardSize != nil {
+		innerMap["shard_size"] = *agg.shardSize
+	}
+
+	outerMap := map[string]interface{}{
+		"sampler": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// DiversifiedSamplerAggregation represents an aggregation of type
+// "diversified_sampler", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-diversified-sampler-aggregation.html
+type DiversifiedSamplerAggregation struct {
+	name            string
+	field           string
+	shardSize       *uint64
+	maxDocsPerValue *uint64
+	executionHint   string
+	aggs            []Aggregation
+}
+
+// DiversifiedSampler creates a new aggregation of type "diversified_sampler"
+// with the provided name. Like Sampler, it restricts its sub-aggregations to
+// the top-scoring documents of each shard, but limits the number of sampled
+// documents sharing the same value of the provided field.
+func DiversifiedSampler(name, field string) *DiversifiedSamplerAggregation {
+	return &DiversifiedSamplerAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *DiversifiedSamplerAggregation) Name() string {
+	return agg.name
+}
+
+// ShardSize sets the number of top-scoring documents sampled from each shard
+// (ElasticSearch defaults to 100).
+func (agg *DiversifiedSamplerAggregation) ShardSize(size uint64) *DiversifiedSamplerAggregation {
+	agg.shardSize = &size
+	return agg
+}
+
+// MaxDocsPerValue sets the maximum number of sampled documents sharing the
+// same field value (ElasticSearch defaults to 1).
+func (agg *DiversifiedSamplerAggregation) MaxDocsPerValue(n uint64) *DiversifiedSamplerAggregation {
+	agg.maxDocsPerValue = &n
+	return agg
+}
+
+// ExecutionHint sets how de-duplication is performed: "map",
+// "global_ordinals" or "bytes_hash".
+func (agg *DiversifiedSamplerAggregation) ExecutionHint(hint string) *DiversifiedSamplerAggregation {
+	agg.executionHint = hint
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *DiversifiedSamplerAggregation) Aggs(aggs ...Aggregation) *DiversifiedSamplerAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *DiversifiedSamplerAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field": agg.field,
+	}
+	if agg.shardSize != nil {
+		innerMap["shard_size"] = *agg.shardSize
+	}
+	if agg.maxDocsPerValue != nil {
+		innerMap["max_docs_per_value"] = *agg.maxDocsPerValue
+	}
+	if agg.executionHint != "" {
+		innerMap["execution_hint"] = agg.executionHint
+	}
+
+	outerMap := map[string]interface{}{
+		"diversified_sampler": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
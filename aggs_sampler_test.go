@@ -0,0 +1,56 @@
+package elasticsearch
+
+import "testing"
+
+func TestSamplerAggs(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"sampler agg: simple",
+			Sampler("sample"),
+			map[string]interface{}{
+				"sampler": map[string]interface{}{},
+			},
+		},
+		{
+			"sampler agg: with shard size and aggs",
+			Sampler("sample").
+				ShardSize(200).
+				Aggs(SignificantTerms("keywords", "tags")),
+			map[string]interface{}{
+				"sampler": map[string]interface{}{
+					"shard_size": 200,
+				},
+				"aggs": map[string]interface{}{
+					"keywords": map[string]interface{}{
+						"significant_terms": map[string]interface{}{
+							"field": "tags",
+						},
+					},
+				},
+			},
+		},
+		{
+			"diversified_sampler agg",
+			DiversifiedSampler("my_unbiased_sample", "author").
+				ShardSize(200).
+				MaxDocsPerValue(3).
+				ExecutionHint("map").
+				Aggs(SignificantTerms("keywords", "tags")),
+			map[string]interface{}{
+				"diversified_sampler": map[string]interface{}{
+					"field":              "author",
+					"shard_size":         200,
+					"max_docs_per_value": 3,
+					"execution_hint":     "map",
+				},
+				"aggs": map[string]interface{}{
+					"keywords": map[string]interface{}{
+						"significant_terms": map[string]interface{}{
+							"field": "tags",
+						},
+					},
+				},
+			},
+		},
+	})
+}
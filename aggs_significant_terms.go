@@ -0,0 +1,163 @@
+package elasticsearch
+
+// SignificantTermsAggregation represents an aggregation of type
+// "significant_terms", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-significantterms-aggregation.html
+type SignificantTermsAggregation struct {
+	name             string
+	field            string
+	size             *uint64
+	minDocCount      *uint64
+	heuristic        *SignificanceHeuristic
+	backgroundFilter Mappable
+	aggs             []Aggregation
+}
+
+// SignificantTerms creates a new aggregation of type "significant_terms" with
+// the provided name and on the provided field. It returns the terms that are
+// unusually common in the documents matching the query (the foreground set),
+// compared to all documents in the index (the background set).
+func SignificantTerms(name, field string) *SignificantTermsAggregation {
+	return &SignificantTermsAggregation{
+		name:  name,
+		field: field,
+	}
+}
+
+// Name returns the name of the aggregation.
+func (agg *SignificantTermsAggregation) Name() string {
+	return agg.name
+}
+
+// Size sets the number of term buckets to return.
+func (agg *SignificantTermsAggregation) Size(size uint64) *SignificantTermsAggregation {
+	agg.size = &size
+	return agg
+}
+
+// MinDocCount sets the minimum number of documents a term must appear in to be
+// returned (ElasticSearch defaults to 3).
+func (agg *SignificantTermsAggregation) MinDocCount(count uint64) *SignificantTermsAggregation {
+	agg.minDocCount = &count
+	return agg
+}
+
+// Heuristic sets the heuristic used to score the significance of terms, such
+// as JLH (the default), ChiSquare, GND or MutualInformation.
+func (agg *SignificantTermsAggregation) Heuristic(
+	h *SignificanceHeuristic,
+) *SignificantTermsAggregation {
+	agg.heuristic = h
+	return agg
+}
+
+// BackgroundFilter sets a query narrowing down the background set the terms
+// are compared against, instead of the whole index.
+func (agg *SignificantTermsAggregation) BackgroundFilter(q Mappable) *SignificantTermsAggregation {
+	agg.backgroundFilter = q
+	return agg
+}
+
+// Aggs sets sub-aggregations for the aggregation.
+func (agg *SignificantTermsAggregation) Aggs(aggs ...Aggregation) *SignificantTermsAggregation {
+	agg.aggs = aggs
+	return agg
+}
+
+// Map returns a map representation of the aggregation, thus implementing the
+// Mappable interface.
+func (agg *SignificantTermsAggregation) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field": agg.field,
+	}
+	if agg.size != nil {
+		innerMap["size"] = *agg.size
+	}
+	if agg.minDocCount != nil {
+		innerMap["min_doc_count"] = *agg.minDocCount
+	}
+	if agg.heuristic != nil {
+		innerMap[agg.heuristic.name] = agg.heuristic.params
+	}
+	if agg.backgroundFilter != nil {
+		innerMap["background_filter"] = agg.backgroundFilter.Map()
+	}
+
+	outerMap := map[string]interface{}{
+		"significant_terms": innerMap,
+	}
+	if len(agg.aggs) > 0 {
+		outerMap["aggs"] = aggsMap(agg.aggs)
+	}
+
+	return outerMap
+}
+
+//----------------------------------------------------------------------------//
+
+// SignificanceHeuristic represents a heuristic scoring the significance of
+// terms in a significant_terms aggregation, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/
+//
+//	search-aggregations-bucket-significantterms-aggregation.html#_parameters
+type SignificanceHeuristic struct {
+	name   string
+	params map[string]interface{}
+}
+
+// Map returns a map representation of the heuristic, thus implementing the
+// Mappable interface.
+func (h *SignificanceHeuristic) Map() map[string]interface{} {
+	return map[string]interface{}{
+		h.name: h.params,
+	}
+}
+
+// JLH creates the "jlh" significance heuristic, which ElasticSearch uses by
+// default.
+func JLH() *SignificanceHeuristic {
+	return &SignificanceHeuristic{
+		name:   "jlh",
+		params: map[string]interface{}{},
+	}
+}
+
+// ChiSquare creates the "chi_square" significance heuristic. includeNegatives
+// sets whether terms that are less frequent in the foreground set than in the
+// background set are returned, and backgroundIsSuperset whether the background
+// set contains the foreground set (false when using a background filter that
+// excludes it).
+func ChiSquare(includeNegatives, backgroundIsSuperset bool) *SignificanceHeuristic {
+	return &SignificanceHeuristic{
+		name: "chi_square",
+		params: map[string]interface{}{
+			"include_negatives":      includeNegatives,
+			"background_is_superset": backgroundIsSuperset,
+		},
+	}
+}
+
+// GND creates the "gnd" (Google normalized distance) significance heuristic.
+// backgroundIsSuperset is the same as for ChiSquare.
+func GND(backgroundIsSuperset bool) *SignificanceHeuristic {
+	return &SignificanceHeuristic{
+		name: "gnd",
+		params: map[string]interface{}{
+			"background_is_superset": backgroundIsSuperset,
+		},
+	}
+}
+
+// MutualInformation creates the "mutual_information" significance heuristic.
+// Its parameters are the same as for ChiSquare.
+func MutualInformation(includeNegatives, backgroundIsSuperset bool) *SignificanceHeuristic {
+	return &SignificanceHeuristic{
+		name: "mutual_information",
+		params: map[string]interface{}{
+			"include_negatives":      includeNegatives,
+			"background_is_superset": backgroundIsSuperset,
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package elasticsearch
+
+import "testing"
+
+func TestSignificantTermsAggs(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"significant_terms agg: simple",
+			SignificantTerms("crime_types", "crime_type"),
+			map[string]interface{}{
+				"significant_terms": map[string]interface{}{
+					"field": "crime_type",
+				},
+			},
+		},
+		{
+			"significant_terms agg: with jlh heuristic",
+			SignificantTerms("crime_types", "crime_type").Size(5).Heuristic(JLH()),
+			map[string]interface{}{
+				"significant_terms": map[string]interface{}{
+					"field": "crime_type",
+					"size":  5,
+					"jlh":   map[string]interface{}{},
+				},
+			},
+		},
+		{
+			"significant_terms agg: with chi_square heuristic and background filter",
+			SignificantTerms("keywords", "text").
+				MinDocCount(10).
+				Heuristic(ChiSquare(false, true)).
+				BackgroundFilter(Term("category", "tech")).
+				Aggs(Cardinality("authors", "author")),
+			map[string]interface{}{
+				"significant_terms": map[string]interface{}{
+					"field":         "text",
+					"min_doc_count": 10,
+					"chi_square": map[string]interface{}{
+						"include_negatives":      false,
+						"background_is_superset": true,
+					},
+					"background_filter": map[string]interface{}{
+						"term": map[string]interface{}{
+							"category": map[string]interface{}{
+								"value": "tech",
+							},
+						},
+					},
+				},
+				"aggs": map[string]interface{}{
+					"authors": map[string]interface{}{
+						"cardinality": map[string]interface{}{
+							"field": "author",
+						},
+					},
+				},
+			},
+		},
+		{
+			"gnd heuristic",
+			GND(false),
+			map[string]interface{}{
+				"gnd": map[string]interface{}{
+					"background_is_superset": false,
+				},
+			},
+		},
+		{
+			"mutual_information heuristic",
+			MutualInformation(true, true),
+			map[string]interface{}{
+				"mutual_information": map[string]interface{}{
+					"include_negatives":      true,
+					"background_is_superset": true,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package elasticsearch
+
+// CollapseOption represents the "collapse" option of a search request, which
+// collapses search results based on field values, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/collapse-search-results.html
+type CollapseOption struct {
+	field                      string
+	innerHits                  []*InnerHits
+	maxConcurrentGroupSearches *uint64
+}
+
+// Collapse creates a new collapse option on the provided field, which must be
+// a keyword or numeric field with doc values enabled. Only the top hit is
+// returned for each value of the field.
+func Collapse(field string) *CollapseOption {
+	return &CollapseOption{field: field}
+}
+
+// InnerHits sets inner hits to expand each collapsed group with, such as the
+// top N documents of each group. Multiple inner hits must have distinct names.
+func (c *CollapseOption) InnerHits(ih ...*InnerHits) *CollapseOption {
+	c.innerHits = ih
+	return c
+}
+
+// MaxConcurrentGroupSearches sets the number of concurrent requests allowed to
+// retrieve the inner hits of each group.
+func (c *CollapseOption) MaxConcurrentGroupSearches(n uint64) *CollapseOption {
+	c.maxConcurrentGroupSearches = &n
+	return c
+}
+
+// Map returns a map representation of the collapse option, thus implementing
+// the Mappable interface.
+func (c *CollapseOption) Map() map[string]interface{} {
+	m := map[string]interface{}{
+		"field": c.field,
+	}
+
+	switch len(c.innerHits) {
+	case 0:
+	case 1:
+		m["inner_hits"] = c.innerHits[0].Map()
+	default:
+		innerHits := make([]map[string]interface{}, len(c.innerHits))
+		for i, ih := range c.innerHits {
+			innerHits[i] = ih.Map()
+		}
+		m["inner_hits"] = innerHits
+	}
+	if c.maxConcurrentGroupSearches != nil {
+		m["max_concurrent_group_searches"] = *c.maxConcurrentGroupSearches
+	}
+
+	return m
+}
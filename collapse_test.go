@@ -0,0 +1,69 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestCollapse(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"collapse with inner hits",
+			Search().
+				Query(Match("message", "elasticsearch")).
+				Collapse(
+					Collapse("user_id").
+						InnerHits(
+							NewInnerHits().
+								Name("most_recent").
+								Size(5).
+								Sort("date", OrderDesc),
+						).
+						MaxConcurrentGroupSearches(4),
+				),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match": map[string]interface{}{
+						"message": map[string]interface{}{
+							"query": "elasticsearch",
+						},
+					},
+				},
+				"collapse": map[string]interface{}{
+					"field": "user_id",
+					"inner_hits": map[string]interface{}{
+						"name": "most_recent",
+						"size": 5,
+						"sort": []map[string]interface{}{
+							{"date": map[string]interface{}{"order": "desc"}},
+						},
+					},
+					"max_concurrent_group_searches": 4,
+				},
+			},
+		},
+		{
+			"collapse with multiple inner hits",
+			Collapse("user_id").InnerHits(
+				NewInnerHits().Name("largest").Sort("likes", OrderDesc),
+				NewInnerHits().Name("smallest").Sort("likes", OrderAsc),
+			),
+			map[string]interface{}{
+				"field": "user_id",
+				"inner_hits": []map[string]interface{}{
+					{
+						"name": "largest",
+						"sort": []map[string]interface{}{
+							{"likes": map[string]interface{}{"order": "desc"}},
+						},
+					},
+					{
+						"name": "smallest",
+						"sort": []map[string]interface{}{
+							{"likes": map[string]interface{}{"order": "asc"}},
+						},
+					},
+				},
+			},
+		},
+	})
+}
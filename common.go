@@ -1,8 +1,10 @@
 package elasticsearch
 
 // Source represents the "_source" option which is commonly accepted in ES
-// queries. Currently, only the "includes" option is supported.
+// queries. It supports the "includes" and "excludes" options, as well as
+// disabling source retrieval altogether.
 type Source struct {
+	disabled bool
 	includes []string
 	excludes []string
 }
@@ -32,3 +34,66 @@ const (
 	// OrderDesc represents sorting in descending order.
 	OrderDesc Order = "desc"
 )
+
+// GeoPoint represents a geographical point, expressed as latitude and
+// longitude.
+type GeoPoint struct {
+	Lat float64 `structs:"lat"`
+	Lon float64 `structs:"lon"`
+}
+
+// Map returns a map representation of the point, thus implementing the
+// Mappable interface.
+func (p GeoPoint) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"lat": p.Lat,
+		"lon": p.Lon,
+	}
+}
+
+// DocRef references an existing document by its index and ID, e.g. in the
+// "like" clause of a more_like_this query.
+type DocRef struct {
+	Index string
+	ID    string
+}
+
+// Map returns a map representation of the document reference, thus
+// implementing the Mappable interface.
+func (d DocRef) Map() map[string]interface{} {
+	m := map[string]interface{}{
+		"_id": d.ID,
+	}
+	if d.Index != "" {
+		m["_index"] = d.Index
+	}
+	return m
+}
+
+// DistanceType is an enumeration type representing supported methods for
+// computing geographical distances.
+type DistanceType uint8
+
+const (
+	_ DistanceType = iota
+
+	// DistanceTypeArc is the "arc" distance type
+	DistanceTypeArc
+
+	// DistanceTypePlane is the "plane" distance type (faster, but less
+	// accurate on long distances and close to the poles)
+	DistanceTypePlane
+)
+
+// String returns a string representation of the distance type, as known to
+// ElasticSearch.
+func (a DistanceType) String() string {
+	switch a {
+	case DistanceTypeArc:
+		return "arc"
+	case DistanceTypePlane:
+		return "plane"
+	default:
+		return ""
+	}
+}
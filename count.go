@@ -3,6 +3,7 @@ package elasticsearch
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
@@ -60,3 +61,39 @@ func (req *CountRequest) RunCount(
 
 	return count(opts...)
 }
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the number of matching documents. Zero or more count options can be provided
+// as well. The response body is closed by Do. Unsuccessful responses are
+// returned as an *ESError.
+func (req *CountRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.CountRequest),
+) (count int64, err error) {
+	return req.DoCount(api.Count, o...)
+}
+
+// DoCount is the same as the Do method, except that it accepts a value of type
+// esapi.Count (usually this is the Count field of an elasticsearch.Client
+// object), just like the RunCount method.
+func (req *CountRequest) DoCount(
+	count esapi.Count,
+	o ...func(*esapi.CountRequest),
+) (int64, error) {
+	res, err := CheckResponse(req.RunCount(count, o...))
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	var body struct {
+		Count int64 `json:"count"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return 0, fmt.Errorf("failed decoding count response: %w", err)
+	}
+
+	return body.Count, nil
+}
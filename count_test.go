@@ -1,6 +1,12 @@
 package elasticsearch
 
-import "testing"
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
 
 func TestCount(t *testing.T) {
 	runMapTests(t, []mapTest{
@@ -15,3 +21,22 @@ func TestCount(t *testing.T) {
 		},
 	})
 }
+
+func TestCountDo(t *testing.T) {
+	var body string
+	count := esapi.Count(func(o ...func(*esapi.CountRequest)) (*esapi.Response, error) {
+		var req esapi.CountRequest
+		for _, f := range o {
+			f(&req)
+		}
+		b, _ := ioutil.ReadAll(req.Body)
+		body = string(b)
+
+		return fakeResponse(200, `{"count":42,"_shards":{"total":1}}`), nil
+	})
+
+	n, err := Count(Term("user", "kimchy")).DoCount(count)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), n)
+	assert.Equal(t, `{"query":{"term":{"user":{"value":"kimchy"}}}}`+"\n", body)
+}
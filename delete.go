@@ -3,6 +3,7 @@ package elasticsearch
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
@@ -12,8 +13,12 @@ import (
 // described in
 // https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-delete-by-query.html
 type DeleteRequest struct {
-	index []string
-	query Mappable
+	index             []string
+	query             Mappable
+	conflicts         string
+	refresh           *bool
+	slices            *int
+	waitForCompletion *bool
 }
 
 // Delete creates a new DeleteRequest object, to be filled via method chaining.
@@ -21,6 +26,12 @@ func Delete() *DeleteRequest {
 	return &DeleteRequest{}
 }
 
+// DeleteByQuery creates a new DeleteRequest object for the provided query. It
+// is the same as calling Delete().Query(q).
+func DeleteByQuery(q Mappable) *DeleteRequest {
+	return Delete().Query(q)
+}
+
 // Index sets the index names for the request
 func (req *DeleteRequest) Index(index ...string) *DeleteRequest {
 	req.index = index
@@ -33,7 +44,38 @@ func (req *DeleteRequest) Query(q Mappable) *DeleteRequest {
 	return req
 }
 
-// Run executes the request using the provided ElasticSearch client.
+// Conflicts sets what to do when version conflicts occur: "abort" (the
+// default) or "proceed".
+func (req *DeleteRequest) Conflicts(c string) *DeleteRequest {
+	req.conflicts = c
+	return req
+}
+
+// Refresh sets whether the affected indices are refreshed once the request
+// completes.
+func (req *DeleteRequest) Refresh(b bool) *DeleteRequest {
+	req.refresh = &b
+	return req
+}
+
+// Slices sets the number of slices the request is divided into, allowing it to
+// run in parallel. Note that the "auto" value is not supported by version 7.6
+// of the official client, which only accepts a number.
+func (req *DeleteRequest) Slices(n int) *DeleteRequest {
+	req.slices = &n
+	return req
+}
+
+// WaitForCompletion sets whether the request blocks until the operation
+// completes. If false, ElasticSearch starts a task and returns its ID instead.
+func (req *DeleteRequest) WaitForCompletion(b bool) *DeleteRequest {
+	req.waitForCompletion = &b
+	return req
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more delete by query options can be provided as well; they take precedence
+// over the options set on the request.
 func (req *DeleteRequest) Run(
 	api *elasticsearch.Client,
 	o ...func(*esapi.DeleteByQueryRequest),
@@ -59,5 +101,52 @@ func (req *DeleteRequest) RunDelete(
 		return nil, err
 	}
 
-	return del(req.index, &b, o...)
+	var opts []func(*esapi.DeleteByQueryRequest)
+	if req.conflicts != "" {
+		opts = append(opts, del.WithConflicts(req.conflicts))
+	}
+	if req.refresh != nil {
+		opts = append(opts, del.WithRefresh(*req.refresh))
+	}
+	if req.slices != nil {
+		opts = append(opts, del.WithSlices(*req.slices))
+	}
+	if req.waitForCompletion != nil {
+		opts = append(opts, del.WithWaitForCompletion(*req.waitForCompletion))
+	}
+
+	return del(req.index, &b, append(opts, o...)...)
+}
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the decoded result, which includes the number of deleted documents and of
+// version conflicts. The response body is closed by Do. Unsuccessful responses
+// are returned as an *ESError.
+func (req *DeleteRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.DeleteByQueryRequest),
+) (*ByQueryResult, error) {
+	return req.DoDelete(api.DeleteByQuery, o...)
+}
+
+// DoDelete is the same as the Do method, except that it accepts a value of
+// type esapi.DeleteByQuery, just like the RunDelete method.
+func (req *DeleteRequest) DoDelete(
+	del esapi.DeleteByQuery,
+	o ...func(*esapi.DeleteByQueryRequest),
+) (*ByQueryResult, error) {
+	res, err := CheckResponse(req.RunDelete(del, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var result ByQueryResult
+	err = json.NewDecoder(res.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding delete by query response: %w", err)
+	}
+
+	return &result, nil
 }
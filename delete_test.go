@@ -0,0 +1,44 @@
+package elasticsearch
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestDeleteDo(t *testing.T) {
+	var reqIndex []string
+	var reqBody string
+	var req esapi.DeleteByQueryRequest
+	del := esapi.DeleteByQuery(func(index []string, body io.Reader, o ...func(*esapi.DeleteByQueryRequest)) (*esapi.Response, error) {
+		for _, f := range o {
+			f(&req)
+		}
+		b, _ := ioutil.ReadAll(body)
+		reqIndex, reqBody = index, string(b)
+
+		return fakeResponse(200,
+			`{"took":20,"timed_out":false,"total":5,"deleted":4,"version_conflicts":1,"failures":[]}`,
+		), nil
+	})
+
+	res, err := DeleteByQuery(Range("expires_at").Lt("now")).
+		Index("sessions").
+		Conflicts("proceed").
+		Refresh(true).
+		Slices(4).
+		WaitForCompletion(true).
+		DoDelete(del)
+	assert.Nil(t, err)
+	assert.DeepEqual(t, []string{"sessions"}, reqIndex)
+	assert.Equal(t, `{"query":{"range":{"expires_at":{"lt":"now"}}}}`+"\n", reqBody)
+	assert.Equal(t, "proceed", req.Conflicts)
+	assert.True(t, req.Refresh != nil && *req.Refresh)
+	assert.True(t, req.Slices != nil && *req.Slices == 4)
+	assert.True(t, req.WaitForCompletion != nil && *req.WaitForCompletion)
+	assert.Equal(t, int64(4), res.Deleted)
+	assert.Equal(t, int64(1), res.VersionConflicts)
+}
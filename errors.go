@@ -0,0 +1,121 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// ESError represents an error returned by ElasticSearch, as parsed from the
+// body of an unsuccessful response.
+type ESError struct {
+	// Status is the HTTP status code of the response.
+	Status int
+
+	// Type is the type of the error (e.g. "index_not_found_exception").
+	Type string
+
+	// Reason is a human readable description of the error.
+	Reason string
+
+	// RootCause is the list of underlying errors that caused the error.
+	RootCause []ErrorCause
+}
+
+// ErrorCause represents an underlying cause of an ESError.
+type ErrorCause struct {
+	// Type is the type of the error.
+	Type string `json:"type"`
+
+	// Reason is a human readable description of the error.
+	Reason string `json:"reason"`
+}
+
+// Error returns a string representation of the error, thus implementing the
+// error interface.
+func (e *ESError) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("elasticsearch: [%d] %s", e.Status, e.Reason)
+	}
+
+	return fmt.Sprintf("elasticsearch: [%d] %s: %s", e.Status, e.Type, e.Reason)
+}
+
+// CheckResponse inspects the response of a request, returning an *ESError if
+// it is unsuccessful (in which case the response body is closed). Errors from
+// executing the request are returned unchanged. It is meant to wrap calls to
+// the various Run methods provided by the library, e.g.:
+//
+//	res, err := elasticsearch.CheckResponse(req.Run(es))
+func CheckResponse(res *esapi.Response, err error) (*esapi.Response, error) {
+	if err != nil {
+		return res, err
+	}
+	if !res.IsError() {
+		return res, nil
+	}
+
+	defer res.Body.Close()
+
+	return nil, ParseError(res.StatusCode, res.Body)
+}
+
+// RunChecked is the same as the Run method, except that unsuccessful responses
+// are returned as an *ESError, see CheckResponse.
+func (req *SearchRequest) RunChecked(
+	api *elasticsearch.Client,
+	o ...func(*esapi.SearchRequest),
+) (res *esapi.Response, err error) {
+	return CheckResponse(req.Run(api, o...))
+}
+
+// ParseError creates an ESError from the status code and body of an
+// unsuccessful response. ElasticSearch usually returns an object describing
+// the error, but falls back to a plain string in some cases, which is then used
+// as the reason. It is only needed for responses that do not come from the v7
+// client, as CheckResponse already uses it.
+func ParseError(status int, r io.Reader) *ESError {
+	esErr := &ESError{Status: status}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		esErr.Reason = err.Error()
+		return esErr
+	}
+
+	var body struct {
+		Error  json.RawMessage `json:"error"`
+		Status int             `json:"status"`
+	}
+	if json.Unmarshal(b, &body) != nil || len(body.Error) == 0 {
+		esErr.Reason = strings.TrimSpace(string(b))
+		return esErr
+	}
+	if body.Status != 0 {
+		esErr.Status = body.Status
+	}
+
+	var details struct {
+		ErrorCause
+		RootCause []ErrorCause `json:"root_cause"`
+	}
+	if json.Unmarshal(body.Error, &details) != nil {
+		var reason string
+		if err := json.Unmarshal(body.Error, &reason); err != nil {
+			reason = string(body.Error)
+		}
+		esErr.Reason = reason
+		return esErr
+	}
+
+	esErr.Type = details.Type
+	esErr.Reason = details.Reason
+	esErr.RootCause = details.RootCause
+
+	return esErr
+}
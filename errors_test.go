@@ -0,0 +1,84 @@
+package elasticsearch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		res    *esapi.Response
+		expErr *ESError
+	}{
+		{
+			"successful response",
+			fakeResponse(200, `{"acknowledged": true}`),
+			nil,
+		},
+		{
+			"structured error",
+			fakeResponse(404, `{
+				"error": {
+					"root_cause": [{"type": "index_not_found_exception", "reason": "no such index [test]"}],
+					"type": "index_not_found_exception",
+					"reason": "no such index [test]"
+				},
+				"status": 404
+			}`),
+			&ESError{
+				Status: 404,
+				Type:   "index_not_found_exception",
+				Reason: "no such index [test]",
+				RootCause: []ErrorCause{
+					{Type: "index_not_found_exception", Reason: "no such index [test]"},
+				},
+			},
+		},
+		{
+			"string error",
+			fakeResponse(400, `{"error": "something went wrong", "status": 400}`),
+			&ESError{Status: 400, Reason: "something went wrong"},
+		},
+		{
+			"non-string, non-object error",
+			fakeResponse(500, `{"error": 42, "status": 500}`),
+			&ESError{Status: 500, Reason: "42"},
+		},
+		{
+			"unparsable error",
+			fakeResponse(502, "Bad Gateway\n"),
+			&ESError{Status: 502, Reason: "Bad Gateway"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := CheckResponse(test.res, nil)
+			if test.expErr == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, test.res, res)
+				return
+			}
+
+			assert.True(t, res == nil)
+			esErr, ok := err.(*ESError)
+			assert.True(t, ok)
+			assert.DeepEqual(t, test.expErr, esErr)
+		})
+	}
+
+	t.Run("request error", func(t *testing.T) {
+		reqErr := errors.New("connection refused")
+		_, err := CheckResponse(nil, reqErr)
+		assert.Equal(t, reqErr, err)
+	})
+}
+
+func TestESErrorString(t *testing.T) {
+	err := &ESError{Status: 400, Type: "parsing_exception", Reason: "unknown query [foo]"}
+	assert.Equal(t, "elasticsearch: [400] parsing_exception: unknown query [foo]", err.Error())
+}
@@ -76,20 +76,25 @@
 //
 // # Notes
 //
-//   - elasticsearch currently supports version 7 of the ElasticSearch Go client.
+//   - elasticsearch supports version 7 of the ElasticSearch Go client. Version
+//     8 is supported via the esv8 sub-package.
 //
-//   - The library cannot currently generate "short queries". For example,
-//     whereas ElasticSearch can accept this:
+//   - By default, the library generates the verbose form of queries. For
+//     example, whereas ElasticSearch can accept this:
 //
 //     { "query": { "term": { "user": "Kimchy" } } }
 //
-// The library will always generate this:
+// The library will generate this:
 //
 //	{ "query": { "term": { "user": { "value": "Kimchy" } } } }
 //
-// This is also true for queries such as "bool", where fields like "must" can
-// either receive one query object, or an array of query objects. `elasticsearch` will
-// generate an array even if there's only one query object.
+// Leaf queries such as "term", "match", "prefix", "regexp", "wildcard" and
+// "fuzzy" provide a Short method that generates the short form instead, as long
+// as no option other than the value is set.
+//
+// Queries such as "bool", where fields like "must" can either receive one query
+// object or an array of query objects, always generate an array, even if
+// there's only one query object.
 package elasticsearch
 
 // Mappable is the interface implemented by the various query and aggregation
@@ -106,3 +111,27 @@ type Aggregation interface {
 	Mappable
 	Name() string
 }
+
+// aggsMap merges the map representations of the provided aggregations into a
+// single object keyed by their names, as expected under an "aggs" key. Each
+// map is taken as-is, so sub-aggregations of bucket aggregations are preserved.
+func aggsMap(aggs []Aggregation) map[string]interface{} {
+	m := make(map[string]interface{}, len(aggs))
+	for _, agg := range aggs {
+		m[agg.Name()] = agg.Map()
+	}
+
+	return m
+}
+
+// leafParams returns the parameters of a leaf query. If short is true and the
+// provided key is the only key in params, its value is returned, which
+// generates the short form of the query. Otherwise, params is returned as-is so
+// no option is lost.
+func leafParams(params map[string]interface{}, key string, short bool) interface{} {
+	if v, ok := params[key]; ok && short && len(params) == 1 {
+		return v
+	}
+
+	return params
+}
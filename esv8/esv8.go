@@ -0,0 +1,199 @@
+// Package esv8 allows executing requests built with the elasticsearch package
+// using version 8 of the official Go client
+// (github.com/elastic/go-elasticsearch/v8).
+//
+// The query and aggregation builders only produce maps, so they are shared
+// between both client versions: requests are built with the elasticsearch
+// package as usual, and executed via the functions of this package instead of
+// the Run methods, which are bound to the v7 client. For example:
+//
+//	res, err := esv8.Search(
+//	    es8,
+//	    elasticsearch.Search().Query(elasticsearch.Term("user", "kimchy")),
+//	    es8.Search.WithIndex("tweets"),
+//	)
+//
+// The DSL generated by the library is valid for both ElasticSearch 7 and 8.
+// The differences worth knowing about are on the server side: mapping types
+// are removed in 8 (the library never emits them), and "hits.total" is always
+// an object unless rest_total_hits_as_int is set, which DecodeResult handles
+// either way.
+package esv8
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	es "github.com/khulnasoft/elasticsearch"
+)
+
+// Search executes a search request using the provided ElasticSearch client.
+// The provided value can either be a SearchRequest, or a query, in which case
+// a SearchRequest is created for it. Zero or more search options can be
+// provided as well. It returns the standard Response type of the official Go
+// client.
+func Search(
+	api *elasticsearch.Client,
+	q es.Mappable,
+	o ...func(*esapi.SearchRequest),
+) (*esapi.Response, error) {
+	return RunSearch(api.Search, q, o...)
+}
+
+// RunSearch is the same as Search, except that it accepts a value of type
+// esapi.Search (usually this is the Search field of an elasticsearch.Client
+// object), which allows using mock clients in tests.
+func RunSearch(
+	search esapi.Search,
+	q es.Mappable,
+	o ...func(*esapi.SearchRequest),
+) (*esapi.Response, error) {
+	req, ok := q.(*es.SearchRequest)
+	if !ok {
+		req = es.Query(q)
+	}
+
+	var b bytes.Buffer
+	err := json.NewEncoder(&b).Encode(req.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := append([]func(*esapi.SearchRequest){search.WithBody(&b)}, o...)
+
+	return search(opts...)
+}
+
+// SearchTyped is the v8 equivalent of elasticsearch.SearchTyped: it executes a
+// search request and decodes the source of each hit into a value of type T.
+// The response body is closed by SearchTyped, and unsuccessful responses are
+// returned as an *elasticsearch.ESError.
+func SearchTyped[T any](
+	q es.Mappable,
+	api *elasticsearch.Client,
+	o ...func(*esapi.SearchRequest),
+) (*es.Result[T], error) {
+	return RunSearchTyped[T](q, api.Search, o...)
+}
+
+// RunSearchTyped is the same as SearchTyped, except that it accepts a value of
+// type esapi.Search, just like RunSearch.
+func RunSearchTyped[T any](
+	q es.Mappable,
+	search esapi.Search,
+	o ...func(*esapi.SearchRequest),
+) (*es.Result[T], error) {
+	res, err := CheckResponse(RunSearch(search, q, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	return es.DecodeResult[T](res.Body)
+}
+
+// Count executes a count request for the provided query using the provided
+// ElasticSearch client.
+func Count(
+	api *elasticsearch.Client,
+	q es.Mappable,
+	o ...func(*esapi.CountRequest),
+) (*esapi.Response, error) {
+	return RunCount(api.Count, q, o...)
+}
+
+// RunCount is the same as Count, except that it accepts a value of type
+// esapi.Count (usually this is the Count field of an elasticsearch.Client
+// object).
+func RunCount(
+	count esapi.Count,
+	q es.Mappable,
+	o ...func(*esapi.CountRequest),
+) (*esapi.Response, error) {
+	var b bytes.Buffer
+	err := json.NewEncoder(&b).Encode(es.Count(q).Map())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := append([]func(*esapi.CountRequest){count.WithBody(&b)}, o...)
+
+	return count(opts...)
+}
+
+// MultiSearch executes a multi search request using the provided
+// ElasticSearch client.
+func MultiSearch(
+	api *elasticsearch.Client,
+	req *es.MultiSearchRequest,
+	o ...func(*esapi.MsearchRequest),
+) (*esapi.Response, error) {
+	return RunMsearch(api.Msearch, req, o...)
+}
+
+// RunMsearch is the same as MultiSearch, except that it accepts a value of
+// type esapi.Msearch (usually this is the Msearch field of an
+// elasticsearch.Client object).
+func RunMsearch(
+	msearch esapi.Msearch,
+	req *es.MultiSearchRequest,
+	o ...func(*esapi.MsearchRequest),
+) (*esapi.Response, error) {
+	body, err := req.MarshalNDJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return msearch(bytes.NewReader(body), o...)
+}
+
+// DeleteByQuery executes a delete by query request for the provided query on
+// the provided indices, using the provided ElasticSearch client.
+func DeleteByQuery(
+	api *elasticsearch.Client,
+	index []string,
+	q es.Mappable,
+	o ...func(*esapi.DeleteByQueryRequest),
+) (*esapi.Response, error) {
+	return RunDeleteByQuery(api.DeleteByQuery, index, q, o...)
+}
+
+// RunDeleteByQuery is the same as DeleteByQuery, except that it accepts a
+// value of type esapi.DeleteByQuery (usually this is the DeleteByQuery field
+// of an elasticsearch.Client object).
+func RunDeleteByQuery(
+	del esapi.DeleteByQuery,
+	index []string,
+	q es.Mappable,
+	o ...func(*esapi.DeleteByQueryRequest),
+) (*esapi.Response, error) {
+	var b bytes.Buffer
+	err := json.NewEncoder(&b).Encode(map[string]interface{}{
+		"query": q.Map(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return del(index, &b, o...)
+}
+
+// CheckResponse is the v8 equivalent of elasticsearch.CheckResponse: it
+// returns an *elasticsearch.ESError if the response is unsuccessful (in which
+// case the response body is closed), and errors from executing the request
+// unchanged.
+func CheckResponse(res *esapi.Response, err error) (*esapi.Response, error) {
+	if err != nil {
+		return res, err
+	}
+	if !res.IsError() {
+		return res, nil
+	}
+
+	defer res.Body.Close()
+
+	return nil, es.ParseError(res.StatusCode, res.Body)
+}
@@ -0,0 +1,137 @@
+package esv8
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/jgroeneveld/trial/assert"
+	es "github.com/khulnasoft/elasticsearch"
+)
+
+type testDoc struct {
+	Title string `json:"title"`
+}
+
+func readBody(body io.Reader) string {
+	if body == nil {
+		return ""
+	}
+	b, _ := ioutil.ReadAll(body)
+	return strings.TrimSpace(string(b))
+}
+
+func fakeResponse(status int, body string) *esapi.Response {
+	return &esapi.Response{
+		StatusCode: status,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func fakeSearch(status int, resBody string, reqBody *string) esapi.Search {
+	return func(o ...func(*esapi.SearchRequest)) (*esapi.Response, error) {
+		var req esapi.SearchRequest
+		for _, f := range o {
+			f(&req)
+		}
+		*reqBody = readBody(req.Body)
+		return fakeResponse(status, resBody), nil
+	}
+}
+
+func TestRunSearch(t *testing.T) {
+	var reqBody string
+	search := fakeSearch(200, `{}`, &reqBody)
+
+	t.Run("query", func(t *testing.T) {
+		_, err := RunSearch(search, es.Term("user", "kimchy"))
+		assert.Nil(t, err)
+		assert.Equal(t, `{"query":{"term":{"user":{"value":"kimchy"}}}}`, reqBody)
+	})
+
+	t.Run("search request", func(t *testing.T) {
+		_, err := RunSearch(search, es.Search().Query(es.MatchAll()).Size(0))
+		assert.Nil(t, err)
+		assert.Equal(t, `{"query":{"match_all":{}},"size":0}`, reqBody)
+	})
+}
+
+func TestRunSearchTyped(t *testing.T) {
+	var reqBody string
+
+	t.Run("success", func(t *testing.T) {
+		search := fakeSearch(200, `{
+			"hits": {
+				"total": {"value": 1, "relation": "eq"},
+				"max_score": 1.5,
+				"hits": [
+					{"_index": "posts", "_id": "1", "_score": 1.5, "_source": {"title": "Go"}}
+				]
+			}
+		}`, &reqBody)
+
+		res, err := RunSearchTyped[testDoc](es.Term("tag", "tech"), search)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.Hits))
+		assert.Equal(t, "Go", res.Hits[0].Source.Title)
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		search := fakeSearch(404, `{
+			"error": {"type": "index_not_found_exception", "reason": "no such index [posts]"},
+			"status": 404
+		}`, &reqBody)
+
+		_, err := RunSearchTyped[testDoc](es.Term("tag", "tech"), search)
+		var esErr *es.ESError
+		assert.True(t, errors.As(err, &esErr))
+		assert.Equal(t, 404, esErr.Status)
+		assert.Equal(t, "index_not_found_exception", esErr.Type)
+	})
+}
+
+func TestRunCount(t *testing.T) {
+	var reqBody string
+	count := esapi.Count(func(o ...func(*esapi.CountRequest)) (*esapi.Response, error) {
+		var req esapi.CountRequest
+		for _, f := range o {
+			f(&req)
+		}
+		reqBody = readBody(req.Body)
+		return fakeResponse(200, `{"count":3}`), nil
+	})
+
+	_, err := RunCount(count, es.Term("user", "kimchy"))
+	assert.Nil(t, err)
+	assert.Equal(t, `{"query":{"term":{"user":{"value":"kimchy"}}}}`, reqBody)
+}
+
+func TestRunMsearch(t *testing.T) {
+	var reqBody string
+	msearch := esapi.Msearch(func(body io.Reader, o ...func(*esapi.MsearchRequest)) (*esapi.Response, error) {
+		reqBody = readBody(body)
+		return fakeResponse(200, `{"responses":[]}`), nil
+	})
+
+	_, err := RunMsearch(msearch, es.MultiSearch().Add("all", es.MatchAll(), "posts"))
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"index\":[\"posts\"]}\n{\"query\":{\"match_all\":{}}}", reqBody)
+}
+
+func TestRunDeleteByQuery(t *testing.T) {
+	var reqBody string
+	var reqIndex []string
+	del := esapi.DeleteByQuery(func(index []string, body io.Reader, o ...func(*esapi.DeleteByQueryRequest)) (*esapi.Response, error) {
+		reqIndex = index
+		reqBody = readBody(body)
+		return fakeResponse(200, `{"deleted":1}`), nil
+	})
+
+	_, err := RunDeleteByQuery(del, []string{"posts"}, es.Term("user", "kimchy"))
+	assert.Nil(t, err)
+	assert.DeepEqual(t, []string{"posts"}, reqIndex)
+	assert.Equal(t, `{"query":{"term":{"user":{"value":"kimchy"}}}}`, reqBody)
+}
@@ -0,0 +1,114 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// ExplainRequest represents a request to ElasticSearch's Explain API, which
+// computes how a query scores a specific document, as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-explain.html
+type ExplainRequest struct {
+	index string
+	id    string
+	query Mappable
+}
+
+// ExplainResult is the decoded response of an explain request.
+type ExplainResult struct {
+	Index       string      `json:"_index"`
+	ID          string      `json:"_id"`
+	Matched     bool        `json:"matched"`
+	Explanation Explanation `json:"explanation"`
+}
+
+// Explanation is a node of the explanation tree of a document's score. The
+// value of a node is computed from the values of its details, as described by
+// its description.
+type Explanation struct {
+	Value       float64       `json:"value"`
+	Description string        `json:"description"`
+	Details     []Explanation `json:"details"`
+}
+
+// Explain creates a new explain request for the document with the provided ID
+// in the provided index, and the provided query.
+func Explain(index, id string, q Mappable) *ExplainRequest {
+	return &ExplainRequest{
+		index: index,
+		id:    id,
+		query: q,
+	}
+}
+
+// Map returns a map representation of the request, thus implementing the
+// Mappable interface.
+func (req *ExplainRequest) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"query": req.query.Map(),
+	}
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more explain options can be provided as well. It returns the standard
+// Response type of the official Go client.
+func (req *ExplainRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.ExplainRequest),
+) (res *esapi.Response, err error) {
+	return req.RunExplain(api.Explain, o...)
+}
+
+// RunExplain is the same as the Run method, except that it accepts a value of
+// type esapi.Explain (usually this is the Explain field of an
+// elasticsearch.Client object), which allows using mock clients.
+func (req *ExplainRequest) RunExplain(
+	explain esapi.Explain,
+	o ...func(*esapi.ExplainRequest),
+) (res *esapi.Response, err error) {
+	var b bytes.Buffer
+	err = json.NewEncoder(&b).Encode(req.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := append([]func(*esapi.ExplainRequest){explain.WithBody(&b)}, o...)
+
+	return explain(req.index, req.id, opts...)
+}
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the decoded explanation. The response body is closed by Do. Unsuccessful
+// responses (including a missing document) are returned as an *ESError.
+func (req *ExplainRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.ExplainRequest),
+) (*ExplainResult, error) {
+	return req.DoExplain(api.Explain, o...)
+}
+
+// DoExplain is the same as the Do method, except that it accepts a value of
+// type esapi.Explain, just like the RunExplain method.
+func (req *ExplainRequest) DoExplain(
+	explain esapi.Explain,
+	o ...func(*esapi.ExplainRequest),
+) (*ExplainResult, error) {
+	res, err := CheckResponse(req.RunExplain(explain, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var result ExplainResult
+	err = json.NewDecoder(res.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding explain response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,45 @@
+package elasticsearch
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestExplainDo(t *testing.T) {
+	var reqIndex, reqID, reqBody string
+	explain := esapi.Explain(func(index, id string, o ...func(*esapi.ExplainRequest)) (*esapi.Response, error) {
+		var req esapi.ExplainRequest
+		for _, f := range o {
+			f(&req)
+		}
+		b, _ := ioutil.ReadAll(req.Body)
+		reqIndex, reqID, reqBody = index, id, string(b)
+
+		return fakeResponse(200, `{
+				"_index": "posts",
+				"_id": "1",
+				"matched": true,
+				"explanation": {
+					"value": 1.5,
+					"description": "weight(title:go in 0)",
+					"details": [
+						{"value": 2.2, "description": "boost", "details": []},
+						{"value": 0.68, "description": "idf", "details": []}
+					]
+				}
+			}`), nil
+	})
+
+	res, err := Explain("posts", "1", Match("title", "go")).DoExplain(explain)
+	assert.Nil(t, err)
+	assert.Equal(t, "posts", reqIndex)
+	assert.Equal(t, "1", reqID)
+	assert.Equal(t, `{"query":{"match":{"title":{"query":"go"}}}}`+"\n", reqBody)
+	assert.True(t, res.Matched)
+	assert.Equal(t, 1.5, res.Explanation.Value)
+	assert.Equal(t, 2, len(res.Explanation.Details))
+	assert.Equal(t, "idf", res.Explanation.Details[1].Description)
+}
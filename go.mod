@@ -1,10 +1,15 @@
 module github.com/khulnasoft/elasticsearch
 
-go 1.13
+go 1.18
 
 require (
 	github.com/elastic/go-elasticsearch/v7 v7.6.0
+	github.com/elastic/go-elasticsearch/v8 v8.10.1
 	github.com/fatih/structs v1.1.0
-	github.com/jgroeneveld/schema v1.0.0 // indirect
 	github.com/jgroeneveld/trial v2.0.0+incompatible
 )
+
+require (
+	github.com/elastic/elastic-transport-go/v8 v8.3.0 // indirect
+	github.com/jgroeneveld/schema v1.0.0 // indirect
+)
@@ -21,6 +21,11 @@ func (q *QueryHighlight) Map() map[string]interface{} {
 	return results
 }
 
+// QueryHighlight represents the "highlight" option of search requests, as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/highlighting.html
+// The same type is used for per-field settings, which override the global
+// settings for that field.
 type QueryHighlight struct {
 	highlightQuery Mappable                   `structs:"highlight_query,omitempty"`
 	fields         map[string]*QueryHighlight `structs:"fields"`
@@ -74,10 +79,12 @@ func (q *QueryHighlight) PostTags(s ...string) *QueryHighlight {
 	return q
 }
 
-// Field sets an entry the highlight query's fields
+// Field sets an entry the highlight query's fields. Per-field settings can
+// optionally be provided; a field without settings is serialized as an empty
+// object and uses the global settings.
 func (q *QueryHighlight) Field(name string, h ...*QueryHighlight) *QueryHighlight {
 	var fld *QueryHighlight
-	if len(h) > 0 {
+	if len(h) > 0 && h[len(h)-1] != nil {
 		fld = h[len(h)-1]
 	} else {
 		fld = &QueryHighlight{}
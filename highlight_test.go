@@ -15,6 +15,49 @@ func TestHighlight(t *testing.T) {
 				},
 			},
 		},
+		{
+			"highlight with per-field settings in a search request",
+			Search().
+				Query(Match("content", "kimchy")).
+				Highlight(
+					Highlight().
+						PreTags("<em>").
+						PostTags("</em>").
+						FragmentSize(150).
+						NumberOfFragments(3).
+						Type(HighlighterFvh).
+						Field("title", nil).
+						Field("content",
+							Highlight().
+								FragmentSize(50).
+								NumberOfFragments(1).
+								Type(HighlighterPlain)),
+				),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match": map[string]interface{}{
+						"content": map[string]interface{}{
+							"query": "kimchy",
+						},
+					},
+				},
+				"highlight": map[string]interface{}{
+					"pre_tags":            []string{"<em>"},
+					"post_tags":           []string{"</em>"},
+					"fragment_size":       150,
+					"number_of_fragments": 3,
+					"type":                "fvh",
+					"fields": map[string]interface{}{
+						"title": map[string]interface{}{},
+						"content": map[string]interface{}{
+							"fragment_size":       50,
+							"number_of_fragments": 1,
+							"type":                "plain",
+						},
+					},
+				},
+			},
+		},
 		{
 			"highlight all params",
 			Highlight().
@@ -0,0 +1,97 @@
+package elasticsearch
+
+// InnerHits represents the "inner_hits" option of nested, has_child and
+// has_parent queries, as well as of field collapsing, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/inner-hits.html
+type InnerHits struct {
+	name   string
+	from   *uint64
+	size   *uint64
+	sort   Sort
+	source Source
+}
+
+// NewInnerHits creates a new InnerHits object, to be filled via method
+// chaining.
+func NewInnerHits() *InnerHits {
+	return &InnerHits{}
+}
+
+// Name sets the name to use for the inner hits in the response. Defaults to
+// the path or type of the enclosing query.
+func (ih *InnerHits) Name(name string) *InnerHits {
+	ih.name = name
+	return ih
+}
+
+// From sets an offset from the first inner hit to return.
+func (ih *InnerHits) From(offset uint64) *InnerHits {
+	ih.from = &offset
+	return ih
+}
+
+// Size sets the maximum number of inner hits to return per hit (the default
+// is 3).
+func (ih *InnerHits) Size(size uint64) *InnerHits {
+	ih.size = &size
+	return ih
+}
+
+// Sort sets how the inner hits should be sorted. By default the inner hits are
+// sorted by score.
+func (ih *InnerHits) Sort(name string, order Order) *InnerHits {
+	ih.sort = append(ih.sort, map[string]interface{}{
+		name: map[string]interface{}{
+			"order": order,
+		},
+	})
+
+	return ih
+}
+
+// SortBy adds one or more sort criteria for the inner hits, such as those
+// created by the SortBy function.
+func (ih *InnerHits) SortBy(sorts ...Mappable) *InnerHits {
+	for _, s := range sorts {
+		ih.sort = append(ih.sort, s.Map())
+	}
+
+	return ih
+}
+
+// SourceIncludes sets the keys to return from the inner hits.
+func (ih *InnerHits) SourceIncludes(keys ...string) *InnerHits {
+	ih.source.includes = keys
+	return ih
+}
+
+// SourceExcludes sets the keys to not return from the inner hits.
+func (ih *InnerHits) SourceExcludes(keys ...string) *InnerHits {
+	ih.source.excludes = keys
+	return ih
+}
+
+// Map returns a map representation of the inner hits, thus implementing the
+// Mappable interface.
+func (ih *InnerHits) Map() map[string]interface{} {
+	m := make(map[string]interface{})
+	if ih.name != "" {
+		m["name"] = ih.name
+	}
+	if ih.from != nil {
+		m["from"] = *ih.from
+	}
+	if ih.size != nil {
+		m["size"] = *ih.size
+	}
+	if len(ih.sort) > 0 {
+		m["sort"] = ih.sort
+	}
+
+	source := ih.source.Map()
+	if len(source) > 0 {
+		m["_source"] = source
+	}
+
+	return m
+}
@@ -0,0 +1,35 @@
+package elasticsearch
+
+import "testing"
+
+func TestInnerHits(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"empty inner hits",
+			NewInnerHits(),
+			map[string]interface{}{},
+		},
+		{
+			"inner hits with all options",
+			NewInnerHits().
+				Name("top_comments").
+				From(0).
+				Size(2).
+				Sort("comments.date", OrderDesc).
+				SourceIncludes("comments.text").
+				SourceExcludes("comments.author"),
+			map[string]interface{}{
+				"name": "top_comments",
+				"from": 0,
+				"size": 2,
+				"sort": []map[string]interface{}{
+					{"comments.date": map[string]interface{}{"order": "desc"}},
+				},
+				"_source": map[string]interface{}{
+					"includes": []string{"comments.text"},
+					"excludes": []string{"comments.author"},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,153 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// MultiSearchRequest represents a request to ElasticSearch's Multi Search API,
+// which executes several search requests at once, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-multi-search.html
+type MultiSearchRequest struct {
+	searches []namedSearch
+}
+
+type namedSearch struct {
+	name  string
+	index []string
+	req   *SearchRequest
+}
+
+// MultiSearch creates a new MultiSearchRequest object, to be filled via method
+// chaining.
+func MultiSearch() *MultiSearchRequest {
+	return &MultiSearchRequest{}
+}
+
+// Add adds a search to the request, under the provided name. The provided
+// value can either be a SearchRequest, or a query, in which case a
+// SearchRequest is created for it. Optionally, the indices to search can be
+// provided, which is otherwise the index (or indices) the multi search request
+// is executed against. Names must be unique, as they are used to key the
+// responses returned by the Do method: requests with duplicate names fail to
+// marshal (and thus to run).
+func (req *MultiSearchRequest) Add(
+	name string,
+	q Mappable,
+	index ...string,
+) *MultiSearchRequest {
+	req.searches = append(req.searches, namedSearch{
+		name:  name,
+		index: index,
+		req:   asSearchRequest(q),
+	})
+	return req
+}
+
+// MarshalNDJSON returns the newline-delimited JSON representation of the
+// request, as expected by the Multi Search API: a header line followed by a
+// body line for every search. It returns an error if several searches have the
+// same name.
+func (req *MultiSearchRequest) MarshalNDJSON() ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	names := make(map[string]bool, len(req.searches))
+	for _, s := range req.searches {
+		if names[s.name] {
+			return nil, fmt.Errorf("duplicate multi search name %q", s.name)
+		}
+		names[s.name] = true
+
+		header := make(map[string]interface{})
+		if len(s.index) > 0 {
+			header["index"] = s.index
+		}
+
+		if err := enc.Encode(header); err != nil {
+			return nil, err
+		}
+		if err := enc.Encode(s.req.Map()); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more multi search options can be provided as well. It returns the standard
+// Response type of the official Go client.
+func (req *MultiSearchRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.MsearchRequest),
+) (res *esapi.Response, err error) {
+	return req.RunMsearch(api.Msearch, o...)
+}
+
+// RunMsearch is the same as the Run method, except that it accepts a value of
+// type esapi.Msearch (usually this is the Msearch field of an
+// elasticsearch.Client object), just like the RunSearch method of
+// SearchRequest.
+func (req *MultiSearchRequest) RunMsearch(
+	msearch esapi.Msearch,
+	o ...func(*esapi.MsearchRequest),
+) (res *esapi.Response, err error) {
+	body, err := req.MarshalNDJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return msearch(bytes.NewReader(body), o...)
+}
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the raw response of every search, keyed by the names provided to Add. Note
+// that the response of an individual search may be an error object. The
+// response body is closed by Do. Unsuccessful responses are returned as an
+// *ESError.
+func (req *MultiSearchRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.MsearchRequest),
+) (map[string]json.RawMessage, error) {
+	return req.DoMsearch(api.Msearch, o...)
+}
+
+// DoMsearch is the same as the Do method, except that it accepts a value of
+// type esapi.Msearch, just like the RunMsearch method.
+func (req *MultiSearchRequest) DoMsearch(
+	msearch esapi.Msearch,
+	o ...func(*esapi.MsearchRequest),
+) (map[string]json.RawMessage, error) {
+	res, err := CheckResponse(req.RunMsearch(msearch, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var body struct {
+		Responses []json.RawMessage `json:"responses"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding multi search response: %w", err)
+	}
+	if len(body.Responses) != len(req.searches) {
+		return nil, fmt.Errorf(
+			"expected %d responses in multi search response, got %d",
+			len(req.searches),
+			len(body.Responses),
+		)
+	}
+
+	responses := make(map[string]json.RawMessage, len(req.searches))
+	for i, s := range req.searches {
+		responses[s.name] = body.Responses[i]
+	}
+
+	return responses, nil
+}
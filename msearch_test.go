@@ -0,0 +1,60 @@
+package elasticsearch
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestMultiSearch(t *testing.T) {
+	var reqBody string
+	msearch := esapi.Msearch(func(body io.Reader, o ...func(*esapi.MsearchRequest)) (*esapi.Response, error) {
+		b, _ := ioutil.ReadAll(body)
+		reqBody = string(b)
+
+		return fakeResponse(200,
+			`{"responses":[{"hits":{"total":{"value":1}}},{"hits":{"total":{"value":2}}}]}`,
+		), nil
+	})
+
+	res, err := MultiSearch().
+		Add("posts", Term("tag", "tech"), "posts").
+		Add("users", Search().Query(MatchAll()).Size(5), "users", "admins").
+		DoMsearch(msearch)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{"index":["posts"]}
+{"query":{"term":{"tag":{"value":"tech"}}}}
+{"index":["users","admins"]}
+{"query":{"match_all":{}},"size":5}
+`, reqBody)
+	assert.Equal(t, `{"hits":{"total":{"value":1}}}`, string(res["posts"]))
+	assert.Equal(t, `{"hits":{"total":{"value":2}}}`, string(res["users"]))
+}
+
+func TestMultiSearchNoIndex(t *testing.T) {
+	b, err := MultiSearch().Add("all", MatchAll()).MarshalNDJSON()
+	assert.Nil(t, err)
+	assert.Equal(t, "{}\n{\"query\":{\"match_all\":{}}}\n", string(b))
+}
+
+func TestMultiSearchDuplicateNames(t *testing.T) {
+	var called bool
+	msearch := esapi.Msearch(func(body io.Reader, o ...func(*esapi.MsearchRequest)) (*esapi.Response, error) {
+		called = true
+		return nil, nil
+	})
+
+	req := MultiSearch().
+		Add("posts", Term("tag", "tech")).
+		Add("posts", Term("tag", "go"))
+
+	_, err := req.MarshalNDJSON()
+	assert.NotNil(t, err)
+	_, err = req.DoMsearch(msearch)
+	assert.NotNil(t, err)
+	assert.False(t, called)
+}
@@ -9,8 +9,9 @@ type BoolQuery struct {
 	filter             []Mappable
 	mustNot            []Mappable
 	should             []Mappable
-	minimumShouldMatch int16
+	minimumShouldMatch interface{}
 	boost              float32
+	name               string
 }
 
 // Bool creates a new compound query of type "bool".
@@ -47,8 +48,10 @@ func (q *BoolQuery) Should(should ...Mappable) *BoolQuery {
 }
 
 // MinimumShouldMatch sets the number or percentage of should clauses returned
-// documents must match.
-func (q *BoolQuery) MinimumShouldMatch(val int16) *BoolQuery {
+// documents must match. The value can either be an integer, or a string such
+// as "75%" or "3<90%". When a bool query only has should clauses, ElasticSearch
+// defaults this to 1; any explicitly set value, including zero, is sent as-is.
+func (q *BoolQuery) MinimumShouldMatch(val interface{}) *BoolQuery {
 	q.minimumShouldMatch = val
 	return q
 }
@@ -59,6 +62,13 @@ func (q *BoolQuery) Boost(val float32) *BoolQuery {
 	return q
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *BoolQuery) Name(name string) *BoolQuery {
+	q.name = name
+	return q
+}
+
 // Map returns a map representation of the bool query, thus implementing
 // the Mappable interface.
 func (q *BoolQuery) Map() map[string]interface{} {
@@ -67,12 +77,14 @@ func (q *BoolQuery) Map() map[string]interface{} {
 		Filter             []map[string]interface{} `structs:"filter,omitempty"`
 		MustNot            []map[string]interface{} `structs:"must_not,omitempty"`
 		Should             []map[string]interface{} `structs:"should,omitempty"`
-		MinimumShouldMatch int16                    `structs:"minimum_should_match,omitempty"`
+		MinimumShouldMatch interface{}              `structs:"minimum_should_match,omitempty"`
 		Boost              float32                  `structs:"boost,omitempty"`
+		Name               string                   `structs:"_name,omitempty"`
 	}
 
 	data.MinimumShouldMatch = q.minimumShouldMatch
 	data.Boost = q.boost
+	data.Name = q.name
 
 	if len(q.must) > 0 {
 		data.Must = make([]map[string]interface{}, len(q.must))
@@ -103,5 +103,70 @@ func TestBool(t *testing.T) {
 				},
 			},
 		},
+		{
+			"bool with percentage minimum_should_match",
+			Bool().
+				Should(Term("tag", "go"), Term("tag", "rust")).
+				MinimumShouldMatch("75%").
+				Boost(2),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"should": []map[string]interface{}{
+						{
+							"term": map[string]interface{}{
+								"tag": map[string]interface{}{
+									"value": "go",
+								},
+							},
+						},
+						{
+							"term": map[string]interface{}{
+								"tag": map[string]interface{}{
+									"value": "rust",
+								},
+							},
+						},
+					},
+					"minimum_should_match": "75%",
+					"boost":                2,
+				},
+			},
+		},
+		{
+			"bool with should-only clauses and explicit zero minimum",
+			Bool().
+				Should(Term("tag", "go")).
+				MinimumShouldMatch(0),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"should": []map[string]interface{}{
+						{
+							"term": map[string]interface{}{
+								"tag": map[string]interface{}{
+									"value": "go",
+								},
+							},
+						},
+					},
+					"minimum_should_match": 0,
+				},
+			},
+		},
+		{
+			"named bool query",
+			Bool().Must(MatchAll().Name("everything")).Name("outer"),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"must": []map[string]interface{}{
+						{
+							"match_all": map[string]interface{}{
+								"_name": "everything",
+							},
+						},
+					},
+					"_name": "outer",
+				},
+			},
+		},
 	})
 }
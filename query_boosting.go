@@ -11,7 +11,11 @@ type BoostingQuery struct {
 	NegBoost float32
 }
 
-// Boosting creates a new compound query of type "boosting".
+// Boosting creates a new compound query of type "boosting". Documents matching
+// the positive query are returned, but those also matching the negative query
+// have their score multiplied by the negative boost, demoting rather than
+// excluding them (unlike a "must_not" clause). The parts are set via the
+// Positive, Negative and NegativeBoost methods.
 func Boosting() *BoostingQuery {
 	return &BoostingQuery{}
 }
@@ -28,7 +32,7 @@ func (q *BoostingQuery) Negative(p Mappable) *BoostingQuery {
 	return q
 }
 
-// NegativeBoost sets the negative boost value.
+// NegativeBoost sets the negative boost value, a number between 0 and 1.0.
 func (q *BoostingQuery) NegativeBoost(b float32) *BoostingQuery {
 	q.NegBoost = b
 	return q
@@ -32,5 +32,31 @@ func TestBoosting(t *testing.T) {
 				},
 			},
 		},
+		{
+			"boosting query demoting discontinued products",
+			Boosting().
+				Positive(Match("name", "laptop")).
+				Negative(Term("status", "discontinued")).
+				NegativeBoost(0.2),
+			map[string]interface{}{
+				"boosting": map[string]interface{}{
+					"positive": map[string]interface{}{
+						"match": map[string]interface{}{
+							"name": map[string]interface{}{
+								"query": "laptop",
+							},
+						},
+					},
+					"negative": map[string]interface{}{
+						"term": map[string]interface{}{
+							"status": map[string]interface{}{
+								"value": "discontinued",
+							},
+						},
+					},
+					"negative_boost": 0.2,
+				},
+			},
+		},
 	})
 }
@@ -0,0 +1,86 @@
+package elasticsearch
+
+import (
+	"github.com/fatih/structs"
+)
+
+// CombinedFieldsQuery represents a query of type "combined_fields", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-combined-fields-query.html
+type CombinedFieldsQuery struct {
+	params combinedFieldsParams
+}
+
+type combinedFieldsParams struct {
+	Qry          string        `structs:"query"`
+	Fields       []string      `structs:"fields"`
+	AutoGenerate *bool         `structs:"auto_generate_synonyms_phrase_query,omitempty"`
+	Op           MatchOperator `structs:"operator,string,omitempty"`
+	MinMatch     string        `structs:"minimum_should_match,omitempty"`
+	ZeroTerms    ZeroTerms     `structs:"zero_terms_query,string,omitempty"`
+	Boost        float32       `structs:"boost,omitempty"`
+	Name         string        `structs:"_name,omitempty"`
+}
+
+// CombinedFields creates a new query of type "combined_fields" with the
+// provided query text and fields. Unlike multi_match, the fields are scored as
+// if they were indexed into a single combined field, using term statistics
+// across all of them. Only text fields sharing the same analyzer are supported;
+// individual fields can be boosted with the caret notation, e.g. "title^2".
+func CombinedFields(query string, fields ...string) *CombinedFieldsQuery {
+	return &CombinedFieldsQuery{
+		params: combinedFieldsParams{
+			Qry:    query,
+			Fields: fields,
+		},
+	}
+}
+
+// AutoGenerateSynonymsPhraseQuery sets the "auto_generate_synonyms_phrase_query"
+// boolean.
+func (q *CombinedFieldsQuery) AutoGenerateSynonymsPhraseQuery(b bool) *CombinedFieldsQuery {
+	q.params.AutoGenerate = &b
+	return q
+}
+
+// Operator sets the boolean logic used to interpret text in the query value.
+func (q *CombinedFieldsQuery) Operator(op MatchOperator) *CombinedFieldsQuery {
+	q.params.Op = op
+	return q
+}
+
+// MinimumShouldMatch sets the minimum number of clauses that must match for a
+// document to be returned.
+func (q *CombinedFieldsQuery) MinimumShouldMatch(s string) *CombinedFieldsQuery {
+	q.params.MinMatch = s
+	return q
+}
+
+// ZeroTermsQuery sets the "zero_terms_query" option to use. This indicates
+// whether no documents are returned if the analyzer removes all tokens, such as
+// when using a stop filter.
+func (q *CombinedFieldsQuery) ZeroTermsQuery(s ZeroTerms) *CombinedFieldsQuery {
+	q.params.ZeroTerms = s
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *CombinedFieldsQuery) Boost(b float32) *CombinedFieldsQuery {
+	q.params.Boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *CombinedFieldsQuery) Name(name string) *CombinedFieldsQuery {
+	q.params.Name = name
+	return q
+}
+
+// Map returns a map representation of the query; implementing the
+// Mappable interface.
+func (q *CombinedFieldsQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"combined_fields": structs.Map(q.params),
+	}
+}
@@ -0,0 +1,42 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestCombinedFields(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"simple combined_fields",
+			CombinedFields("database systems", "title", "body"),
+			map[string]interface{}{
+				"combined_fields": map[string]interface{}{
+					"query":  "database systems",
+					"fields": []string{"title", "body"},
+				},
+			},
+		},
+		{
+			"combined_fields all params",
+			CombinedFields("database systems", "title^2", "body").
+				AutoGenerateSynonymsPhraseQuery(false).
+				Operator(OperatorAnd).
+				MinimumShouldMatch("75%").
+				ZeroTermsQuery(ZeroTermsAll).
+				Boost(1.5).
+				Name("combined"),
+			map[string]interface{}{
+				"combined_fields": map[string]interface{}{
+					"query":                               "database systems",
+					"fields":                              []string{"title^2", "body"},
+					"auto_generate_synonyms_phrase_query": false,
+					"operator":                            "AND",
+					"minimum_should_match":                "75%",
+					"zero_terms_query":                    "all",
+					"boost":                               1.5,
+					"_name":                               "combined",
+				},
+			},
+		},
+	})
+}
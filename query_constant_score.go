@@ -10,8 +10,10 @@ type ConstantScoreQuery struct {
 	boost  float32
 }
 
-// ConstantScore creates a new query of type "contant_score" with the provided
-// filter query.
+// ConstantScore creates a new query of type "constant_score" with the provided
+// filter query. The filter is executed in filter context, so it does not
+// affect scoring; every matching document receives a score equal to the
+// boost (1.0 by default).
 func ConstantScore(filter Mappable) *ConstantScoreQuery {
 	return &ConstantScoreQuery{
 		filter: filter,
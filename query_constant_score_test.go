@@ -37,5 +37,19 @@ func TestConstantScore(t *testing.T) {
 				},
 			},
 		},
+		{
+			"constant_score query wrapping a terms filter",
+			ConstantScore(Terms("tag", "go", "rust")).Boost(1.2),
+			map[string]interface{}{
+				"constant_score": map[string]interface{}{
+					"filter": map[string]interface{}{
+						"terms": map[string]interface{}{
+							"tag": []string{"go", "rust"},
+						},
+					},
+					"boost": 1.2,
+				},
+			},
+		},
 	})
 }
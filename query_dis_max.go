@@ -7,6 +7,7 @@ import "github.com/fatih/structs"
 type DisMaxQuery struct {
 	queries    []Mappable
 	tieBreaker float32
+	boost      float32
 }
 
 // DisMax creates a new compound query of type "dis_max" with the provided
@@ -17,12 +18,20 @@ func DisMax(queries ...Mappable) *DisMaxQuery {
 	}
 }
 
-// TieBreaker sets the "tie_breaker" value for the query.
+// TieBreaker sets the "tie_breaker" value for the query. Scores of matching
+// queries other than the best one are multiplied by it and added to the best
+// score.
 func (q *DisMaxQuery) TieBreaker(b float32) *DisMaxQuery {
 	q.tieBreaker = b
 	return q
 }
 
+// Boost sets the boost value of the query.
+func (q *DisMaxQuery) Boost(b float32) *DisMaxQuery {
+	q.boost = b
+	return q
+}
+
 // Map returns a map representation of the dis_max query, thus implementing
 // the Mappable interface.
 func (q *DisMaxQuery) Map() map[string]interface{} {
@@ -34,6 +43,7 @@ func (q *DisMaxQuery) Map() map[string]interface{} {
 		"dis_max": structs.Map(struct {
 			Queries    []map[string]interface{} `structs:"queries"`
 			TieBreaker float32                  `structs:"tie_breaker,omitempty"`
-		}{inner, q.tieBreaker}),
+			Boost      float32                  `structs:"boost,omitempty"`
+		}{inner, q.tieBreaker, q.boost}),
 	}
 }
@@ -31,5 +31,33 @@ func TestDisMax(t *testing.T) {
 				},
 			},
 		},
+		{
+			"dis_max with boost",
+			DisMax(Match("title", "pets"), Match("body", "pets")).
+				TieBreaker(0.3).
+				Boost(1.5),
+			map[string]interface{}{
+				"dis_max": map[string]interface{}{
+					"queries": []map[string]interface{}{
+						{
+							"match": map[string]interface{}{
+								"title": map[string]interface{}{
+									"query": "pets",
+								},
+							},
+						},
+						{
+							"match": map[string]interface{}{
+								"body": map[string]interface{}{
+									"query": "pets",
+								},
+							},
+						},
+					},
+					"tie_breaker": 0.3,
+					"boost":       1.5,
+				},
+			},
+		},
 	})
 }
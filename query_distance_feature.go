@@ -0,0 +1,62 @@
+package elasticsearch
+
+// DistanceFeatureQuery represents a query of type "distance_feature", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-distance-feature-query.html
+type DistanceFeatureQuery struct {
+	field  string
+	origin interface{}
+	pivot  string
+	boost  float32
+	name   string
+}
+
+// DistanceFeature creates a new query of type "distance_feature", boosting the
+// score of documents the closer the value of the provided date or geo-point
+// field is to the origin. For dates, the origin is a date or date math
+// expression such as "now" and the pivot a duration such as "7d"; for
+// geo-points, the origin is a GeoPoint (or any other geo-point format) and the
+// pivot a distance such as "1km". Documents at the pivot distance from the
+// origin receive half of the boost. The query is usually placed in the
+// "should" clause of a bool query.
+func DistanceFeature(field string, origin interface{}, pivot string) *DistanceFeatureQuery {
+	return &DistanceFeatureQuery{
+		field:  field,
+		origin: origin,
+		pivot:  pivot,
+	}
+}
+
+// Boost sets the boost value of the query, i.e. the score of documents located
+// exactly at the origin (ElasticSearch defaults to 1.0).
+func (q *DistanceFeatureQuery) Boost(b float32) *DistanceFeatureQuery {
+	q.boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *DistanceFeatureQuery) Name(name string) *DistanceFeatureQuery {
+	q.name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *DistanceFeatureQuery) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field":  q.field,
+		"origin": geoPointValue(q.origin),
+		"pivot":  q.pivot,
+	}
+	if q.boost != 0 {
+		innerMap["boost"] = q.boost
+	}
+	if q.name != "" {
+		innerMap["_name"] = q.name
+	}
+
+	return map[string]interface{}{
+		"distance_feature": innerMap,
+	}
+}
@@ -0,0 +1,39 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestDistanceFeature(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"distance_feature on a date field",
+			DistanceFeature("production_date", "now", "7d"),
+			map[string]interface{}{
+				"distance_feature": map[string]interface{}{
+					"field":  "production_date",
+					"origin": "now",
+					"pivot":  "7d",
+				},
+			},
+		},
+		{
+			"distance_feature on a geo-point field",
+			DistanceFeature("location", GeoPoint{Lat: 40.7, Lon: -74}, "1km").
+				Boost(2).
+				Name("nearby"),
+			map[string]interface{}{
+				"distance_feature": map[string]interface{}{
+					"field": "location",
+					"origin": map[string]interface{}{
+						"lat": 40.7,
+						"lon": -74,
+					},
+					"pivot": "1km",
+					"boost": 2,
+					"_name": "nearby",
+				},
+			},
+		},
+	})
+}
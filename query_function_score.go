@@ -0,0 +1,209 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+// FunctionScoreQuery represents a compound query of type "function_score", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-function-score-query.html
+type FunctionScoreQuery struct {
+	query     Mappable
+	functions []*ScoreFunction
+	params    functionScoreParams
+}
+
+type functionScoreParams struct {
+	BoostMode string  `structs:"boost_mode,omitempty"`
+	ScoreMode string  `structs:"score_mode,omitempty"`
+	MaxBoost  float32 `structs:"max_boost,omitempty"`
+	MinScore  float64 `structs:"min_score,omitempty"`
+	Boost     float32 `structs:"boost,omitempty"`
+}
+
+// FunctionScore creates a new compound query of type "function_score" with the
+// provided query. The query may be nil, in which case ElasticSearch defaults
+// to a match_all query.
+func FunctionScore(query Mappable) *FunctionScoreQuery {
+	return &FunctionScoreQuery{
+		query: query,
+	}
+}
+
+// AddFunction adds one or more score functions to the query. AddFunction can
+// be called multiple times, functions will be appended to existing ones.
+func (q *FunctionScoreQuery) AddFunction(fns ...*ScoreFunction) *FunctionScoreQuery {
+	q.functions = append(q.functions, fns...)
+	return q
+}
+
+// BoostMode sets how the computed score is combined with the score of the
+// query (e.g. "multiply", "replace", "sum", "avg", "max", "min").
+func (q *FunctionScoreQuery) BoostMode(mode string) *FunctionScoreQuery {
+	q.params.BoostMode = mode
+	return q
+}
+
+// ScoreMode sets how the scores of the different functions are combined (e.g.
+// "multiply", "sum", "avg", "first", "max", "min").
+func (q *FunctionScoreQuery) ScoreMode(mode string) *FunctionScoreQuery {
+	q.params.ScoreMode = mode
+	return q
+}
+
+// MaxBoost sets the maximum value the computed score can reach.
+func (q *FunctionScoreQuery) MaxBoost(b float32) *FunctionScoreQuery {
+	q.params.MaxBoost = b
+	return q
+}
+
+// MinScore sets the minimum score documents must have in order to be
+// returned.
+func (q *FunctionScoreQuery) MinScore(s float64) *FunctionScoreQuery {
+	q.params.MinScore = s
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *FunctionScoreQuery) Boost(b float32) *FunctionScoreQuery {
+	q.params.Boost = b
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *FunctionScoreQuery) Map() map[string]interface{} {
+	innerMap := structs.Map(q.params)
+	if q.query != nil {
+		innerMap["query"] = q.query.Map()
+	}
+	if len(q.functions) > 0 {
+		functions := make([]map[string]interface{}, len(q.functions))
+		for i, fn := range q.functions {
+			functions[i] = fn.Map()
+		}
+		innerMap["functions"] = functions
+	}
+
+	return map[string]interface{}{
+		"function_score": innerMap,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// ScoreFunction represents a single function of a "function_score" query.
+// Every function can optionally be restricted to documents matching a filter,
+// and have its result multiplied by a weight. ScoreFunction values are created
+// via the FieldValueFactor, RandomScore, ScriptScore, Gauss, Linear and Exp
+// functions.
+type ScoreFunction struct {
+	kind   string
+	body   map[string]interface{}
+	filter Mappable
+	weight *float32
+}
+
+// Filter sets a filter query, so that the function is only applied to the
+// documents that match it.
+func (fn *ScoreFunction) Filter(filter Mappable) *ScoreFunction {
+	fn.filter = filter
+	return fn
+}
+
+// Weight sets a weight the function's score is multiplied by.
+func (fn *ScoreFunction) Weight(w float32) *ScoreFunction {
+	fn.weight = &w
+	return fn
+}
+
+// Map returns a map representation of the function, thus implementing the
+// Mappable interface. The filter and weight of the function are placed next
+// to the function body, as ElasticSearch expects.
+func (fn *ScoreFunction) Map() map[string]interface{} {
+	m := map[string]interface{}{
+		fn.kind: fn.body,
+	}
+	if fn.filter != nil {
+		m["filter"] = fn.filter.Map()
+	}
+	if fn.weight != nil {
+		m["weight"] = *fn.weight
+	}
+
+	return m
+}
+
+// FieldValueFactor creates a new score function of type "field_value_factor",
+// which computes the score from the value of the provided field. The modifier
+// (e.g. "log1p", "sqrt") and factor are omitted when empty. The missing value,
+// used for documents without the field, is always sent, so that 0 can be used.
+func FieldValueFactor(field, modifier string, factor, missing float64) *ScoreFunction {
+	return &ScoreFunction{
+		kind: "field_value_factor",
+		body: structs.Map(struct {
+			Field    string  `structs:"field"`
+			Factor   float64 `structs:"factor,omitempty"`
+			Modifier string  `structs:"modifier,omitempty"`
+			Missing  float64 `structs:"missing"`
+		}{field, factor, modifier, missing}),
+	}
+}
+
+// RandomScore creates a new score function of type "random_score". To get
+// reproducible scores, provide a non-nil seed and the name of the field to
+// take random values from (usually "_seq_no"), otherwise pass nil and an
+// empty string.
+func RandomScore(seed interface{}, field string) *ScoreFunction {
+	return &ScoreFunction{
+		kind: "random_score",
+		body: structs.Map(struct {
+			Seed  interface{} `structs:"seed,omitempty"`
+			Field string      `structs:"field,omitempty"`
+		}{seed, field}),
+	}
+}
+
+// ScriptScore creates a new score function of type "script_score", which
+// computes the score using the provided script, usually a *Script.
+func ScriptScore(script Mappable) *ScoreFunction {
+	return &ScoreFunction{
+		kind: "script_score",
+		body: map[string]interface{}{
+			"script": script.Map(),
+		},
+	}
+}
+
+// Gauss creates a new decay function of type "gauss" on the provided field.
+// The offset and decay parameters are optional and may be nil.
+func Gauss(field string, origin, scale, offset, decay interface{}) *ScoreFunction {
+	return newDecayFunction("gauss", field, origin, scale, offset, decay)
+}
+
+// Linear creates a new decay function of type "linear" on the provided field.
+// The offset and decay parameters are optional and may be nil.
+func Linear(field string, origin, scale, offset, decay interface{}) *ScoreFunction {
+	return newDecayFunction("linear", field, origin, scale, offset, decay)
+}
+
+// Exp creates a new decay function of type "exp" on the provided field. The
+// offset and decay parameters are optional and may be nil.
+func Exp(field string, origin, scale, offset, decay interface{}) *ScoreFunction {
+	return newDecayFunction("exp", field, origin, scale, offset, decay)
+}
+
+func newDecayFunction(
+	kind, field string,
+	origin, scale, offset, decay interface{},
+) *ScoreFunction {
+	return &ScoreFunction{
+		kind: kind,
+		body: map[string]interface{}{
+			field: structs.Map(struct {
+				Origin interface{} `structs:"origin,omitempty"`
+				Scale  interface{} `structs:"scale"`
+				Offset interface{} `structs:"offset,omitempty"`
+				Decay  interface{} `structs:"decay,omitempty"`
+			}{origin, scale, offset, decay}),
+		},
+	}
+}
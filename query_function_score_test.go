@@ -0,0 +1,121 @@
+package elasticsearch
+
+import "testing"
+
+func TestFunctionScore(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"function_score with a single function",
+			FunctionScore(Match("title", "elasticsearch")).
+				AddFunction(FieldValueFactor("likes", "log1p", 1.2, 1)),
+			map[string]interface{}{
+				"function_score": map[string]interface{}{
+					"query": map[string]interface{}{
+						"match": map[string]interface{}{
+							"title": map[string]interface{}{
+								"query": "elasticsearch",
+							},
+						},
+					},
+					"functions": []map[string]interface{}{
+						{
+							"field_value_factor": map[string]interface{}{
+								"field":    "likes",
+								"factor":   1.2,
+								"modifier": "log1p",
+								"missing":  1,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"field_value_factor with a zero missing value",
+			FieldValueFactor("likes", "", 0, 0),
+			map[string]interface{}{
+				"field_value_factor": map[string]interface{}{
+					"field":   "likes",
+					"missing": 0,
+				},
+			},
+		},
+		{
+			"function_score with filters, weights and decay functions",
+			FunctionScore(nil).
+				AddFunction(
+					Gauss("date", "now", "10d", "5d", 0.5).
+						Filter(Term("type", "post")).
+						Weight(2),
+					Linear("price", 0, 20, nil, nil),
+					Exp("location", "11,12", "2km", nil, nil),
+					RandomScore(10, "_seq_no").Weight(0.5),
+					ScriptScore(CustomQuery(map[string]interface{}{
+						"source": "Math.log(2 + doc['likes'].value)",
+					})),
+				).
+				ScoreMode("sum").
+				BoostMode("multiply").
+				MaxBoost(42).
+				MinScore(3),
+			map[string]interface{}{
+				"function_score": map[string]interface{}{
+					"functions": []map[string]interface{}{
+						{
+							"gauss": map[string]interface{}{
+								"date": map[string]interface{}{
+									"origin": "now",
+									"scale":  "10d",
+									"offset": "5d",
+									"decay":  0.5,
+								},
+							},
+							"filter": map[string]interface{}{
+								"term": map[string]interface{}{
+									"type": map[string]interface{}{
+										"value": "post",
+									},
+								},
+							},
+							"weight": 2,
+						},
+						{
+							"linear": map[string]interface{}{
+								"price": map[string]interface{}{
+									"origin": 0,
+									"scale":  20,
+								},
+							},
+						},
+						{
+							"exp": map[string]interface{}{
+								"location": map[string]interface{}{
+									"origin": "11,12",
+									"scale":  "2km",
+								},
+							},
+						},
+						{
+							"random_score": map[string]interface{}{
+								"seed":  10,
+								"field": "_seq_no",
+							},
+							"weight": 0.5,
+						},
+						{
+							"script_score": map[string]interface{}{
+								"script": map[string]interface{}{
+									"source": "Math.log(2 + doc['likes'].value)",
+								},
+							},
+						},
+					},
+					"score_mode": "sum",
+					"boost_mode": "multiply",
+					"max_boost":  42,
+					"min_score":  3,
+				},
+			},
+		},
+	})
+}
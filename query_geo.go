@@ -0,0 +1,427 @@
+package elasticsearch
+
+import (
+	"errors"
+
+	"github.com/fatih/structs"
+)
+
+// ValidationMethod is an enumeration type representing how geo queries handle
+// invalid latitude and longitude values.
+type ValidationMethod uint8
+
+const (
+	_ ValidationMethod = iota
+
+	// ValidationStrict is the "STRICT" validation method
+	ValidationStrict
+
+	// ValidationIgnoreMalformed is the "IGNORE_MALFORMED" validation method
+	ValidationIgnoreMalformed
+
+	// ValidationCoerce is the "COERCE" validation method
+	ValidationCoerce
+)
+
+// String returns a string representation of the validation method, as known
+// to ElasticSearch.
+func (a ValidationMethod) String() string {
+	switch a {
+	case ValidationStrict:
+		return "STRICT"
+	case ValidationIgnoreMalformed:
+		return "IGNORE_MALFORMED"
+	case ValidationCoerce:
+		return "COERCE"
+	default:
+		return ""
+	}
+}
+
+// geoPointValue returns the representation of a geo-point as expected in a
+// query: GeoPoint values (and other Mappables) are converted to maps, while
+// other values such as [lon, lat] arrays or geohash strings are used as-is.
+func geoPointValue(point interface{}) interface{} {
+	if m, ok := point.(Mappable); ok {
+		return m.Map()
+	}
+	return point
+}
+
+//----------------------------------------------------------------------------//
+
+// GeoDistanceQuery represents a query of type "geo_distance", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-distance-query.html
+type GeoDistanceQuery struct {
+	field  string
+	origin interface{}
+	params geoDistanceParams
+}
+
+type geoDistanceParams struct {
+	Distance         string           `structs:"distance,omitempty"`
+	DistanceType     DistanceType     `structs:"distance_type,string,omitempty"`
+	ValidationMethod ValidationMethod `structs:"validation_method,string,omitempty"`
+	Name             string           `structs:"_name,omitempty"`
+}
+
+// GeoDistance creates a new query of type "geo_distance", matching documents
+// whose geo-point field is within a certain distance of the provided latitude
+// and longitude. The distance is set via the Distance method.
+func GeoDistance(field string, lat, lon float64) *GeoDistanceQuery {
+	return GeoDistanceFrom(field, GeoPoint{Lat: lat, Lon: lon})
+}
+
+// GeoDistanceFrom is the same as GeoDistance, except that the origin can be
+// provided in any format ElasticSearch supports for geo-points, such as a
+// GeoPoint, a []float64{lon, lat} array, or a geohash string.
+func GeoDistanceFrom(field string, origin interface{}) *GeoDistanceQuery {
+	return &GeoDistanceQuery{
+		field:  field,
+		origin: origin,
+	}
+}
+
+// Distance sets the radius of the circle around the origin, e.g. "12km". It is
+// required.
+func (q *GeoDistanceQuery) Distance(distance string) *GeoDistanceQuery {
+	q.params.Distance = distance
+	return q
+}
+
+// Validate checks that the distance is set, as ElasticSearch rejects the query
+// otherwise.
+func (q *GeoDistanceQuery) Validate() error {
+	if q.params.Distance == "" {
+		return errors.New("geo_distance query requires a distance")
+	}
+
+	return nil
+}
+
+// DistanceType sets how the distance is computed.
+func (q *GeoDistanceQuery) DistanceType(t DistanceType) *GeoDistanceQuery {
+	q.params.DistanceType = t
+	return q
+}
+
+// ValidationMethod sets how invalid latitude and longitude values are
+// handled.
+func (q *GeoDistanceQuery) ValidationMethod(v ValidationMethod) *GeoDistanceQuery {
+	q.params.ValidationMethod = v
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *GeoDistanceQuery) Name(name string) *GeoDistanceQuery {
+	q.params.Name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface. The query is not validated; use Validate for that.
+func (q *GeoDistanceQuery) Map() map[string]interface{} {
+	inner := structs.Map(q.params)
+	inner[q.field] = geoPointValue(q.origin)
+
+	return map[string]interface{}{
+		"geo_distance": inner,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// BoundingBoxType is an enumeration type representing how a geo_bounding_box
+// query is executed.
+type BoundingBoxType uint8
+
+const (
+	_ BoundingBoxType = iota
+
+	// BoundingBoxMemory is the "memory" execution type
+	BoundingBoxMemory
+
+	// BoundingBoxIndexed is the "indexed" execution type
+	BoundingBoxIndexed
+)
+
+// String returns a string representation of the bounding box type, as known
+// to ElasticSearch.
+func (a BoundingBoxType) String() string {
+	switch a {
+	case BoundingBoxMemory:
+		return "memory"
+	case BoundingBoxIndexed:
+		return "indexed"
+	default:
+		return ""
+	}
+}
+
+// GeoBoundingBoxQuery represents a query of type "geo_bounding_box", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-bounding-box-query.html
+type GeoBoundingBoxQuery struct {
+	field  string
+	box    map[string]interface{}
+	params geoBoundingBoxParams
+}
+
+type geoBoundingBoxParams struct {
+	Type             BoundingBoxType  `structs:"type,string,omitempty"`
+	ValidationMethod ValidationMethod `structs:"validation_method,string,omitempty"`
+	Name             string           `structs:"_name,omitempty"`
+}
+
+// GeoBoundingBox creates a new query of type "geo_bounding_box" on the
+// provided field. The box is set either via the TopLeft and BottomRight
+// methods, or via the Bounds method.
+func GeoBoundingBox(field string) *GeoBoundingBoxQuery {
+	return &GeoBoundingBoxQuery{
+		field: field,
+		box:   make(map[string]interface{}),
+	}
+}
+
+// TopLeft sets the top left corner of the box.
+func (q *GeoBoundingBoxQuery) TopLeft(lat, lon float64) *GeoBoundingBoxQuery {
+	q.box["top_left"] = GeoPoint{Lat: lat, Lon: lon}.Map()
+	return q
+}
+
+// BottomRight sets the bottom right corner of the box.
+func (q *GeoBoundingBoxQuery) BottomRight(lat, lon float64) *GeoBoundingBoxQuery {
+	q.box["bottom_right"] = GeoPoint{Lat: lat, Lon: lon}.Map()
+	return q
+}
+
+// Bounds sets the box via its top and bottom latitudes and its left and right
+// longitudes. It replaces any corners previously set via TopLeft and
+// BottomRight.
+func (q *GeoBoundingBoxQuery) Bounds(top, left, bottom, right float64) *GeoBoundingBoxQuery {
+	q.box = map[string]interface{}{
+		"top":    top,
+		"left":   left,
+		"bottom": bottom,
+		"right":  right,
+	}
+	return q
+}
+
+// Type sets how the query is executed.
+func (q *GeoBoundingBoxQuery) Type(t BoundingBoxType) *GeoBoundingBoxQuery {
+	q.params.Type = t
+	return q
+}
+
+// ValidationMethod sets how invalid latitude and longitude values are
+// handled.
+func (q *GeoBoundingBoxQuery) ValidationMethod(v ValidationMethod) *GeoBoundingBoxQuery {
+	q.params.ValidationMethod = v
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *GeoBoundingBoxQuery) Name(name string) *GeoBoundingBoxQuery {
+	q.params.Name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *GeoBoundingBoxQuery) Map() map[string]interface{} {
+	inner := structs.Map(q.params)
+	inner[q.field] = q.box
+
+	return map[string]interface{}{
+		"geo_bounding_box": inner,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// GeoPolygonQuery represents a query of type "geo_polygon", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-polygon-query.html
+type GeoPolygonQuery struct {
+	field  string
+	points []GeoPoint
+	params geoPolygonParams
+}
+
+type geoPolygonParams struct {
+	ValidationMethod ValidationMethod `structs:"validation_method,string,omitempty"`
+	Name             string           `structs:"_name,omitempty"`
+}
+
+// GeoPolygon creates a new query of type "geo_polygon", matching documents
+// whose geo-point field is within the polygon formed by the provided points.
+func GeoPolygon(field string, points []GeoPoint) *GeoPolygonQuery {
+	return &GeoPolygonQuery{
+		field:  field,
+		points: points,
+	}
+}
+
+// ValidationMethod sets how invalid latitude and longitude values are
+// handled.
+func (q *GeoPolygonQuery) ValidationMethod(v ValidationMethod) *GeoPolygonQuery {
+	q.params.ValidationMethod = v
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *GeoPolygonQuery) Name(name string) *GeoPolygonQuery {
+	q.params.Name = name
+	return q
+}
+
+// Validate checks that the polygon has at least three points, as
+// ElasticSearch rejects the query otherwise.
+func (q *GeoPolygonQuery) Validate() error {
+	if len(q.points) < 3 {
+		return errors.New("geo_polygon query requires at least three points")
+	}
+
+	return nil
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface. The query is not validated; use Validate for that.
+func (q *GeoPolygonQuery) Map() map[string]interface{} {
+	points := make([]map[string]interface{}, len(q.points))
+	for i, point := range q.points {
+		points[i] = point.Map()
+	}
+
+	inner := structs.Map(q.params)
+	inner[q.field] = map[string]interface{}{
+		"points": points,
+	}
+
+	return map[string]interface{}{
+		"geo_polygon": inner,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// ShapeRelation is an enumeration type representing the spatial relations
+// supported by geo_shape queries.
+type ShapeRelation uint8
+
+const (
+	_ ShapeRelation = iota
+
+	// ShapeIntersects is the "intersects" relation (ElasticSearch's default)
+	ShapeIntersects
+
+	// ShapeDisjoint is the "disjoint" relation
+	ShapeDisjoint
+
+	// ShapeWithin is the "within" relation
+	ShapeWithin
+
+	// ShapeContains is the "contains" relation
+	ShapeContains
+)
+
+// String returns a string representation of the shape relation, as known to
+// ElasticSearch.
+func (a ShapeRelation) String() string {
+	switch a {
+	case ShapeIntersects:
+		return "intersects"
+	case ShapeDisjoint:
+		return "disjoint"
+	case ShapeWithin:
+		return "within"
+	case ShapeContains:
+		return "contains"
+	default:
+		return ""
+	}
+}
+
+// GeoShapeQuery represents a query of type "geo_shape", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-shape-query.html
+type GeoShapeQuery struct {
+	field        string
+	shape        map[string]interface{}
+	indexedShape map[string]interface{}
+	relation     ShapeRelation
+	name         string
+}
+
+// GeoShape creates a new query of type "geo_shape" on the provided field. The
+// shape to compare against is set either inline via the Shape method, or as a
+// reference to an indexed shape via the IndexedShape method.
+func GeoShape(field string) *GeoShapeQuery {
+	return &GeoShapeQuery{
+		field: field,
+	}
+}
+
+// Shape sets the shape to compare against, in GeoJSON format, e.g.
+// {"type": "envelope", "coordinates": [[13.0, 53.0], [14.0, 52.0]]}. It
+// replaces any indexed shape previously set.
+func (q *GeoShapeQuery) Shape(geojson map[string]interface{}) *GeoShapeQuery {
+	q.shape = geojson
+	q.indexedShape = nil
+	return q
+}
+
+// IndexedShape sets the shape to compare against to the shape stored in the
+// provided path of the document with the provided index and ID. It replaces
+// any inline shape previously set.
+func (q *GeoShapeQuery) IndexedShape(index, id, path string) *GeoShapeQuery {
+	q.indexedShape = map[string]interface{}{
+		"index": index,
+		"id":    id,
+		"path":  path,
+	}
+	q.shape = nil
+	return q
+}
+
+// Relation sets the spatial relation between the shape and the field's value
+// for documents to match.
+func (q *GeoShapeQuery) Relation(r ShapeRelation) *GeoShapeQuery {
+	q.relation = r
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *GeoShapeQuery) Name(name string) *GeoShapeQuery {
+	q.name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *GeoShapeQuery) Map() map[string]interface{} {
+	fieldMap := make(map[string]interface{})
+	if q.shape != nil {
+		fieldMap["shape"] = q.shape
+	}
+	if q.indexedShape != nil {
+		fieldMap["indexed_shape"] = q.indexedShape
+	}
+	if q.relation != 0 {
+		fieldMap["relation"] = q.relation.String()
+	}
+
+	inner := map[string]interface{}{
+		q.field: fieldMap,
+	}
+	if q.name != "" {
+		inner["_name"] = q.name
+	}
+
+	return map[string]interface{}{
+		"geo_shape": inner,
+	}
+}
@@ -0,0 +1,157 @@
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestGeoQueries(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"geo_distance with lat/lon",
+			GeoDistance("pin.location", 40, -70).
+				Distance("12km").
+				DistanceType(DistanceTypePlane).
+				ValidationMethod(ValidationIgnoreMalformed),
+			map[string]interface{}{
+				"geo_distance": map[string]interface{}{
+					"distance":          "12km",
+					"distance_type":     "plane",
+					"validation_method": "IGNORE_MALFORMED",
+					"pin.location": map[string]interface{}{
+						"lat": 40,
+						"lon": -70,
+					},
+				},
+			},
+		},
+		{
+			"geo_distance with [lon, lat] array",
+			GeoDistanceFrom("pin.location", []float64{-70, 40}).Distance("200m"),
+			map[string]interface{}{
+				"geo_distance": map[string]interface{}{
+					"distance":     "200m",
+					"pin.location": []float64{-70, 40},
+				},
+			},
+		},
+		{
+			"geo_distance with geohash",
+			GeoDistanceFrom("pin.location", "drm3btev3e86").Distance("1km"),
+			map[string]interface{}{
+				"geo_distance": map[string]interface{}{
+					"distance":     "1km",
+					"pin.location": "drm3btev3e86",
+				},
+			},
+		},
+		{
+			"geo_bounding_box with corners",
+			GeoBoundingBox("pin.location").
+				TopLeft(40.73, -74.1).
+				BottomRight(40.01, -71.12).
+				Type(BoundingBoxIndexed),
+			map[string]interface{}{
+				"geo_bounding_box": map[string]interface{}{
+					"type": "indexed",
+					"pin.location": map[string]interface{}{
+						"top_left": map[string]interface{}{
+							"lat": 40.73,
+							"lon": -74.1,
+						},
+						"bottom_right": map[string]interface{}{
+							"lat": 40.01,
+							"lon": -71.12,
+						},
+					},
+				},
+			},
+		},
+		{
+			"geo_bounding_box with bounds",
+			GeoBoundingBox("pin.location").Bounds(40.73, -74.1, 40.01, -71.12),
+			map[string]interface{}{
+				"geo_bounding_box": map[string]interface{}{
+					"pin.location": map[string]interface{}{
+						"top":    40.73,
+						"left":   -74.1,
+						"bottom": 40.01,
+						"right":  -71.12,
+					},
+				},
+			},
+		},
+		{
+			"geo_polygon",
+			GeoPolygon("person.location", []GeoPoint{
+				{Lat: 40, Lon: -70},
+				{Lat: 30, Lon: -80},
+				{Lat: 20, Lon: -90},
+			}).ValidationMethod(ValidationIgnoreMalformed).Name("drawn"),
+			map[string]interface{}{
+				"geo_polygon": map[string]interface{}{
+					"person.location": map[string]interface{}{
+						"points": []map[string]interface{}{
+							{"lat": 40, "lon": -70},
+							{"lat": 30, "lon": -80},
+							{"lat": 20, "lon": -90},
+						},
+					},
+					"validation_method": "IGNORE_MALFORMED",
+					"_name":             "drawn",
+				},
+			},
+		},
+		{
+			"geo_shape with an inline shape",
+			GeoShape("location").
+				Shape(map[string]interface{}{
+					"type":        "envelope",
+					"coordinates": [][]float64{{13, 53}, {14, 52}},
+				}).
+				Relation(ShapeWithin),
+			map[string]interface{}{
+				"geo_shape": map[string]interface{}{
+					"location": map[string]interface{}{
+						"shape": map[string]interface{}{
+							"type":        "envelope",
+							"coordinates": [][]float64{{13, 53}, {14, 52}},
+						},
+						"relation": "within",
+					},
+				},
+			},
+		},
+		{
+			"geo_shape with an indexed shape",
+			GeoShape("location").
+				Shape(map[string]interface{}{"type": "point"}).
+				IndexedShape("shapes", "deu", "location").
+				Name("in_germany"),
+			map[string]interface{}{
+				"geo_shape": map[string]interface{}{
+					"location": map[string]interface{}{
+						"indexed_shape": map[string]interface{}{
+							"index": "shapes",
+							"id":    "deu",
+							"path":  "location",
+						},
+					},
+					"_name": "in_germany",
+				},
+			},
+		},
+	})
+}
+
+func TestGeoPolygonValidate(t *testing.T) {
+	assert.NotNil(t, GeoPolygon("location", nil).Validate())
+	assert.NotNil(t, GeoPolygon("location", []GeoPoint{{Lat: 1, Lon: 1}, {Lat: 2, Lon: 2}}).Validate())
+	assert.Nil(t, GeoPolygon("location", []GeoPoint{{Lat: 1, Lon: 1}, {Lat: 2, Lon: 2}, {Lat: 3, Lon: 1}}).Validate())
+}
+
+func TestGeoDistanceValidate(t *testing.T) {
+	assert.Nil(t, GeoDistance("location", 40, -70).Distance("12km").Validate())
+	assert.NotNil(t, GeoDistance("location", 40, -70).Validate())
+}
@@ -0,0 +1,301 @@
+package elasticsearch
+
+import (
+	"github.com/fatih/structs"
+)
+
+// IntervalsQuery represents a query of type "intervals", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-intervals-query.html
+type IntervalsQuery struct {
+	field string
+	rule  IntervalRule
+}
+
+// Intervals creates a new query of type "intervals" on the provided field,
+// matching documents according to the provided rule. Rules are created with
+// IntervalsMatch, IntervalsPrefix, IntervalsWildcard, IntervalsFuzzy, and
+// combined with IntervalsAllOf and IntervalsAnyOf.
+func Intervals(field string, rule IntervalRule) *IntervalsQuery {
+	return &IntervalsQuery{
+		field: field,
+		rule:  rule,
+	}
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *IntervalsQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"intervals": map[string]interface{}{
+			q.field: q.rule.Map(),
+		},
+	}
+}
+
+// IntervalRule is the interface implemented by the rules of an intervals
+// query. It is only implemented by the rule types of this package.
+type IntervalRule interface {
+	Mappable
+	intervalRule()
+}
+
+//----------------------------------------------------------------------------//
+
+// IntervalsMatchRule represents a "match" rule of an intervals query.
+type IntervalsMatchRule struct {
+	params intervalsMatchParams
+}
+
+type intervalsMatchParams struct {
+	Query    string `structs:"query"`
+	MaxGaps  *int   `structs:"max_gaps,omitempty"`
+	Ordered  *bool  `structs:"ordered,omitempty"`
+	Analyzer string `structs:"analyzer,omitempty"`
+	UseField string `structs:"use_field,omitempty"`
+}
+
+// IntervalsMatch creates a new "match" rule, matching the analyzed terms of
+// the provided text.
+func IntervalsMatch(query string) *IntervalsMatchRule {
+	return &IntervalsMatchRule{
+		params: intervalsMatchParams{
+			Query: query,
+		},
+	}
+}
+
+func (r *IntervalsMatchRule) intervalRule() {}
+
+// MaxGaps sets the maximum number of positions between the matching terms.
+// ElasticSearch defaults to -1 (no limit); 0 requires the terms to be
+// adjacent.
+func (r *IntervalsMatchRule) MaxGaps(n int) *IntervalsMatchRule {
+	r.params.MaxGaps = &n
+	return r
+}
+
+// Ordered sets whether the matching terms must appear in the order of the
+// query.
+func (r *IntervalsMatchRule) Ordered(b bool) *IntervalsMatchRule {
+	r.params.Ordered = &b
+	return r
+}
+
+// Analyzer sets the analyzer used to analyze the query text.
+func (r *IntervalsMatchRule) Analyzer(a string) *IntervalsMatchRule {
+	r.params.Analyzer = a
+	return r
+}
+
+// UseField sets a field to match intervals from instead of the query's field.
+func (r *IntervalsMatchRule) UseField(field string) *IntervalsMatchRule {
+	r.params.UseField = field
+	return r
+}
+
+// Map returns a map representation of the rule, thus implementing the
+// Mappable interface.
+func (r *IntervalsMatchRule) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"match": structs.Map(r.params),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// IntervalsPatternRule represents a "prefix" or "wildcard" rule of an
+// intervals query.
+type IntervalsPatternRule struct {
+	kind   string
+	key    string
+	params intervalsPatternParams
+}
+
+type intervalsPatternParams struct {
+	Analyzer string `structs:"analyzer,omitempty"`
+	UseField string `structs:"use_field,omitempty"`
+}
+
+// IntervalsPrefix creates a new "prefix" rule, matching terms starting with
+// the provided prefix.
+func IntervalsPrefix(prefix string) *IntervalsPatternRule {
+	return &IntervalsPatternRule{
+		kind: "prefix",
+		key:  prefix,
+	}
+}
+
+// IntervalsWildcard creates a new "wildcard" rule, matching terms using the
+// provided wildcard pattern.
+func IntervalsWildcard(pattern string) *IntervalsPatternRule {
+	return &IntervalsPatternRule{
+		kind: "wildcard",
+		key:  pattern,
+	}
+}
+
+func (r *IntervalsPatternRule) intervalRule() {}
+
+// Analyzer sets the analyzer used to normalize the pattern.
+func (r *IntervalsPatternRule) Analyzer(a string) *IntervalsPatternRule {
+	r.params.Analyzer = a
+	return r
+}
+
+// UseField sets a field to match intervals from instead of the query's field.
+func (r *IntervalsPatternRule) UseField(field string) *IntervalsPatternRule {
+	r.params.UseField = field
+	return r
+}
+
+// Map returns a map representation of the rule, thus implementing the
+// Mappable interface.
+func (r *IntervalsPatternRule) Map() map[string]interface{} {
+	innerMap := structs.Map(r.params)
+	if r.kind == "prefix" {
+		innerMap["prefix"] = r.key
+	} else {
+		innerMap["pattern"] = r.key
+	}
+
+	return map[string]interface{}{
+		r.kind: innerMap,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// IntervalsFuzzyRule represents a "fuzzy" rule of an intervals query.
+type IntervalsFuzzyRule struct {
+	params intervalsFuzzyParams
+}
+
+type intervalsFuzzyParams struct {
+	Term           string      `structs:"term"`
+	PrefixLength   uint16      `structs:"prefix_length,omitempty"`
+	Transpositions *bool       `structs:"transpositions,omitempty"`
+	Fuzziness      interface{} `structs:"fuzziness,omitempty"`
+	Analyzer       string      `structs:"analyzer,omitempty"`
+	UseField       string      `structs:"use_field,omitempty"`
+}
+
+// IntervalsFuzzy creates a new "fuzzy" rule, matching terms similar to the
+// provided term.
+func IntervalsFuzzy(term string) *IntervalsFuzzyRule {
+	return &IntervalsFuzzyRule{
+		params: intervalsFuzzyParams{
+			Term: term,
+		},
+	}
+}
+
+func (r *IntervalsFuzzyRule) intervalRule() {}
+
+// PrefixLength sets the number of beginning characters left unchanged when
+// creating expansions.
+func (r *IntervalsFuzzyRule) PrefixLength(l uint16) *IntervalsFuzzyRule {
+	r.params.PrefixLength = l
+	return r
+}
+
+// Transpositions sets whether edits include transpositions of two adjacent
+// characters.
+func (r *IntervalsFuzzyRule) Transpositions(b bool) *IntervalsFuzzyRule {
+	r.params.Transpositions = &b
+	return r
+}
+
+// Fuzziness sets the maximum edit distance allowed for matching, either as an
+// integer or as "AUTO".
+func (r *IntervalsFuzzyRule) Fuzziness(f interface{}) *IntervalsFuzzyRule {
+	r.params.Fuzziness = f
+	return r
+}
+
+// Analyzer sets the analyzer used to normalize the term.
+func (r *IntervalsFuzzyRule) Analyzer(a string) *IntervalsFuzzyRule {
+	r.params.Analyzer = a
+	return r
+}
+
+// UseField sets a field to match intervals from instead of the query's field.
+func (r *IntervalsFuzzyRule) UseField(field string) *IntervalsFuzzyRule {
+	r.params.UseField = field
+	return r
+}
+
+// Map returns a map representation of the rule, thus implementing the
+// Mappable interface.
+func (r *IntervalsFuzzyRule) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"fuzzy": structs.Map(r.params),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// IntervalsCombinationRule represents an "all_of" or "any_of" rule of an
+// intervals query.
+type IntervalsCombinationRule struct {
+	kind    string
+	rules   []IntervalRule
+	maxGaps *int
+	ordered *bool
+}
+
+// IntervalsAllOf creates a new "all_of" rule, matching intervals that combine
+// the intervals of all the provided rules.
+func IntervalsAllOf(rules ...IntervalRule) *IntervalsCombinationRule {
+	return &IntervalsCombinationRule{
+		kind:  "all_of",
+		rules: rules,
+	}
+}
+
+// IntervalsAnyOf creates a new "any_of" rule, matching the intervals of any of
+// the provided rules.
+func IntervalsAnyOf(rules ...IntervalRule) *IntervalsCombinationRule {
+	return &IntervalsCombinationRule{
+		kind:  "any_of",
+		rules: rules,
+	}
+}
+
+func (r *IntervalsCombinationRule) intervalRule() {}
+
+// MaxGaps sets the maximum number of positions between the intervals of the
+// rules ("all_of" only).
+func (r *IntervalsCombinationRule) MaxGaps(n int) *IntervalsCombinationRule {
+	r.maxGaps = &n
+	return r
+}
+
+// Ordered sets whether the intervals of the rules must appear in the order of
+// the rules ("all_of" only).
+func (r *IntervalsCombinationRule) Ordered(b bool) *IntervalsCombinationRule {
+	r.ordered = &b
+	return r
+}
+
+// Map returns a map representation of the rule, thus implementing the
+// Mappable interface.
+func (r *IntervalsCombinationRule) Map() map[string]interface{} {
+	intervals := make([]map[string]interface{}, len(r.rules))
+	for i, rule := range r.rules {
+		intervals[i] = rule.Map()
+	}
+
+	innerMap := map[string]interface{}{
+		"intervals": intervals,
+	}
+	if r.maxGaps != nil {
+		innerMap["max_gaps"] = *r.maxGaps
+	}
+	if r.ordered != nil {
+		innerMap["ordered"] = *r.ordered
+	}
+
+	return map[string]interface{}{
+		r.kind: innerMap,
+	}
+}
@@ -0,0 +1,122 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestIntervals(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"intervals with a match rule",
+			Intervals("body", IntervalsMatch("breach of contract").MaxGaps(0).Ordered(true).Analyzer("standard")),
+			map[string]interface{}{
+				"intervals": map[string]interface{}{
+					"body": map[string]interface{}{
+						"match": map[string]interface{}{
+							"query":    "breach of contract",
+							"max_gaps": 0,
+							"ordered":  true,
+							"analyzer": "standard",
+						},
+					},
+				},
+			},
+		},
+		{
+			"intervals with nested rules",
+			Intervals("my_text", IntervalsAllOf(
+				IntervalsMatch("my favorite food").MaxGaps(0).Ordered(true),
+				IntervalsAnyOf(
+					IntervalsMatch("hot water"),
+					IntervalsMatch("cold porridge"),
+				),
+			).Ordered(true).MaxGaps(5)),
+			map[string]interface{}{
+				"intervals": map[string]interface{}{
+					"my_text": map[string]interface{}{
+						"all_of": map[string]interface{}{
+							"ordered":  true,
+							"max_gaps": 5,
+							"intervals": []map[string]interface{}{
+								{
+									"match": map[string]interface{}{
+										"query":    "my favorite food",
+										"max_gaps": 0,
+										"ordered":  true,
+									},
+								},
+								{
+									"any_of": map[string]interface{}{
+										"intervals": []map[string]interface{}{
+											{
+												"match": map[string]interface{}{
+													"query": "hot water",
+												},
+											},
+											{
+												"match": map[string]interface{}{
+													"query": "cold porridge",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"intervals with term-level rules",
+			Intervals("title", IntervalsAnyOf(
+				IntervalsPrefix("contr"),
+				IntervalsWildcard("agree*").Analyzer("keyword"),
+				IntervalsFuzzy("liability").Fuzziness("AUTO").PrefixLength(2).Transpositions(false),
+			)),
+			map[string]interface{}{
+				"intervals": map[string]interface{}{
+					"title": map[string]interface{}{
+						"any_of": map[string]interface{}{
+							"intervals": []map[string]interface{}{
+								{
+									"prefix": map[string]interface{}{
+										"prefix": "contr",
+									},
+								},
+								{
+									"wildcard": map[string]interface{}{
+										"pattern":  "agree*",
+										"analyzer": "keyword",
+									},
+								},
+								{
+									"fuzzy": map[string]interface{}{
+										"term":           "liability",
+										"fuzziness":      "AUTO",
+										"prefix_length":  2,
+										"transpositions": false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"intervals with an integer fuzziness",
+			Intervals("title", IntervalsFuzzy("contract").Fuzziness(1)),
+			map[string]interface{}{
+				"intervals": map[string]interface{}{
+					"title": map[string]interface{}{
+						"fuzzy": map[string]interface{}{
+							"term":      "contract",
+							"fuzziness": 1,
+						},
+					},
+				},
+			},
+		},
+	})
+}
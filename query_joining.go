@@ -0,0 +1,205 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+// NestedQuery represents a query of type "nested", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-nested-query.html
+type NestedQuery struct {
+	query     Mappable
+	innerHits *InnerHits
+	params    nestedQueryParams
+}
+
+type nestedQueryParams struct {
+	Path           string `structs:"path"`
+	ScoreMode      string `structs:"score_mode,omitempty"`
+	IgnoreUnmapped *bool  `structs:"ignore_unmapped,omitempty"`
+}
+
+// Nested creates a new query of type "nested", which runs the provided query
+// on the nested objects at the provided path.
+func Nested(path string, query Mappable) *NestedQuery {
+	return &NestedQuery{
+		query: query,
+		params: nestedQueryParams{
+			Path: path,
+		},
+	}
+}
+
+// ScoreMode sets how the scores of matching nested objects affect the root
+// document's score ("avg", "max", "min", "none" or "sum").
+func (q *NestedQuery) ScoreMode(mode string) *NestedQuery {
+	q.params.ScoreMode = mode
+	return q
+}
+
+// IgnoreUnmapped sets whether to ignore an unmapped path instead of returning
+// an error.
+func (q *NestedQuery) IgnoreUnmapped(b bool) *NestedQuery {
+	q.params.IgnoreUnmapped = &b
+	return q
+}
+
+// InnerHits sets the query to return the matching nested objects with every
+// hit.
+func (q *NestedQuery) InnerHits(ih *InnerHits) *NestedQuery {
+	q.innerHits = ih
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *NestedQuery) Map() map[string]interface{} {
+	innerMap := structs.Map(q.params)
+	innerMap["query"] = q.query.Map()
+	if q.innerHits != nil {
+		innerMap["inner_hits"] = q.innerHits.Map()
+	}
+
+	return map[string]interface{}{
+		"nested": innerMap,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// HasChildQuery represents a query of type "has_child", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-has-child-query.html
+type HasChildQuery struct {
+	query     Mappable
+	innerHits *InnerHits
+	params    hasChildQueryParams
+}
+
+type hasChildQueryParams struct {
+	Type           string `structs:"type"`
+	ScoreMode      string `structs:"score_mode,omitempty"`
+	MinChildren    uint32 `structs:"min_children,omitempty"`
+	MaxChildren    uint32 `structs:"max_children,omitempty"`
+	IgnoreUnmapped *bool  `structs:"ignore_unmapped,omitempty"`
+}
+
+// HasChild creates a new query of type "has_child", which returns parent
+// documents whose child documents of the provided relation type match the
+// provided query.
+func HasChild(childType string, query Mappable) *HasChildQuery {
+	return &HasChildQuery{
+		query: query,
+		params: hasChildQueryParams{
+			Type: childType,
+		},
+	}
+}
+
+// ScoreMode sets how the scores of matching child documents affect the parent
+// document's score ("avg", "max", "min", "none" or "sum").
+func (q *HasChildQuery) ScoreMode(mode string) *HasChildQuery {
+	q.params.ScoreMode = mode
+	return q
+}
+
+// MinChildren sets the minimum number of matching child documents required for
+// a parent document to match.
+func (q *HasChildQuery) MinChildren(n uint32) *HasChildQuery {
+	q.params.MinChildren = n
+	return q
+}
+
+// MaxChildren sets the maximum number of matching child documents allowed for
+// a parent document to match.
+func (q *HasChildQuery) MaxChildren(n uint32) *HasChildQuery {
+	q.params.MaxChildren = n
+	return q
+}
+
+// IgnoreUnmapped sets whether to ignore an unmapped type instead of returning
+// an error.
+func (q *HasChildQuery) IgnoreUnmapped(b bool) *HasChildQuery {
+	q.params.IgnoreUnmapped = &b
+	return q
+}
+
+// InnerHits sets the query to return the matching child documents with every
+// hit.
+func (q *HasChildQuery) InnerHits(ih *InnerHits) *HasChildQuery {
+	q.innerHits = ih
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *HasChildQuery) Map() map[string]interface{} {
+	innerMap := structs.Map(q.params)
+	innerMap["query"] = q.query.Map()
+	if q.innerHits != nil {
+		innerMap["inner_hits"] = q.innerHits.Map()
+	}
+
+	return map[string]interface{}{
+		"has_child": innerMap,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// HasParentQuery represents a query of type "has_parent", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-has-parent-query.html
+type HasParentQuery struct {
+	query     Mappable
+	innerHits *InnerHits
+	params    hasParentQueryParams
+}
+
+type hasParentQueryParams struct {
+	ParentType     string `structs:"parent_type"`
+	Score          *bool  `structs:"score,omitempty"`
+	IgnoreUnmapped *bool  `structs:"ignore_unmapped,omitempty"`
+}
+
+// HasParent creates a new query of type "has_parent", which returns child
+// documents whose parent document of the provided relation type matches the
+// provided query.
+func HasParent(parentType string, query Mappable) *HasParentQuery {
+	return &HasParentQuery{
+		query: query,
+		params: hasParentQueryParams{
+			ParentType: parentType,
+		},
+	}
+}
+
+// Score sets whether the score of the matching parent document is aggregated
+// into the child documents' scores.
+func (q *HasParentQuery) Score(b bool) *HasParentQuery {
+	q.params.Score = &b
+	return q
+}
+
+// IgnoreUnmapped sets whether to ignore an unmapped parent type instead of
+// returning an error.
+func (q *HasParentQuery) IgnoreUnmapped(b bool) *HasParentQuery {
+	q.params.IgnoreUnmapped = &b
+	return q
+}
+
+// InnerHits sets the query to return the matching parent document with every
+// hit.
+func (q *HasParentQuery) InnerHits(ih *InnerHits) *HasParentQuery {
+	q.innerHits = ih
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *HasParentQuery) Map() map[string]interface{} {
+	innerMap := structs.Map(q.params)
+	innerMap["query"] = q.query.Map()
+	if q.innerHits != nil {
+		innerMap["inner_hits"] = q.innerHits.Map()
+	}
+
+	return map[string]interface{}{
+		"has_parent": innerMap,
+	}
+}
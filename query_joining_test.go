@@ -0,0 +1,105 @@
+package elasticsearch
+
+import "testing"
+
+func TestJoining(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"simple nested",
+			Nested("comments", Match("comments.text", "great")),
+			map[string]interface{}{
+				"nested": map[string]interface{}{
+					"path": "comments",
+					"query": map[string]interface{}{
+						"match": map[string]interface{}{
+							"comments.text": map[string]interface{}{
+								"query": "great",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"nested with inner hits, inside a bool filter",
+			Bool().Filter(
+				Nested("comments", Term("comments.author", "kimchy")).
+					ScoreMode("max").
+					IgnoreUnmapped(true).
+					InnerHits(NewInnerHits().Size(1).Sort("comments.date", OrderDesc)),
+			),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"filter": []map[string]interface{}{
+						{
+							"nested": map[string]interface{}{
+								"path":            "comments",
+								"score_mode":      "max",
+								"ignore_unmapped": true,
+								"query": map[string]interface{}{
+									"term": map[string]interface{}{
+										"comments.author": map[string]interface{}{
+											"value": "kimchy",
+										},
+									},
+								},
+								"inner_hits": map[string]interface{}{
+									"size": 1,
+									"sort": []map[string]interface{}{
+										{"comments.date": map[string]interface{}{"order": "desc"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"has_child query",
+			HasChild("answer", Term("author", "kimchy")).
+				ScoreMode("max").
+				MinChildren(2).
+				MaxChildren(10).
+				InnerHits(NewInnerHits().Size(1)),
+			map[string]interface{}{
+				"has_child": map[string]interface{}{
+					"type":         "answer",
+					"score_mode":   "max",
+					"min_children": 2,
+					"max_children": 10,
+					"query": map[string]interface{}{
+						"term": map[string]interface{}{
+							"author": map[string]interface{}{
+								"value": "kimchy",
+							},
+						},
+					},
+					"inner_hits": map[string]interface{}{
+						"size": 1,
+					},
+				},
+			},
+		},
+		{
+			"has_parent query",
+			HasParent("question", Match("title", "elasticsearch")).
+				Score(true).
+				IgnoreUnmapped(true),
+			map[string]interface{}{
+				"has_parent": map[string]interface{}{
+					"parent_type":     "question",
+					"score":           true,
+					"ignore_unmapped": true,
+					"query": map[string]interface{}{
+						"match": map[string]interface{}{
+							"title": map[string]interface{}{
+								"query": "elasticsearch",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
@@ -32,9 +32,19 @@ const (
 type MatchQuery struct {
 	field  string
 	mType  matchType
+	short  bool
 	params matchParams
 }
 
+// Short generates the query in its short form, where the field maps directly
+// to the query text, e.g. {"match": {"message": "this is a test"}}. If any
+// other option, such as Operator or Fuzziness, is set, the verbose form is
+// generated regardless.
+func (q *MatchQuery) Short() *MatchQuery {
+	q.short = true
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q *MatchQuery) Map() map[string]interface{} {
@@ -52,7 +62,7 @@ func (q *MatchQuery) Map() map[string]interface{} {
 
 	return map[string]interface{}{
 		mType: map[string]interface{}{
-			q.field: structs.Map(q.params),
+			q.field: leafParams(structs.Map(q.params), "query", q.short),
 		},
 	}
 }
@@ -70,7 +80,8 @@ type matchParams struct {
 	Op           MatchOperator `structs:"operator,string,omitempty"`
 	MinMatch     string        `structs:"minimum_should_match,omitempty"`
 	ZeroTerms    ZeroTerms     `structs:"zero_terms_query,string,omitempty"`
-	Slp          uint16        `structs:"slop,omitempty"` // only relevant for match_phrase query
+	Slp          uint16        `structs:"slop,omitempty"` // only relevant for match_phrase and match_phrase_prefix queries
+	Name         string        `structs:"_name,omitempty"`
 }
 
 // Match creates a new query of type "match" with the provided field name.
@@ -81,8 +92,11 @@ func Match(fieldName string, simpleQuery ...interface{}) *MatchQuery {
 }
 
 // MatchBoolPrefix creates a new query of type "match_bool_prefix" with the
-// provided field name. A comparison value can optionally be provided to quickly
-// create a simple query such as { "match": { "message": "this is a test" } }
+// provided field name. The analyzed terms of the query are matched as whole
+// terms, except for the last one which is matched as a prefix, making it
+// suitable for search-as-you-type. A comparison value can optionally be
+// provided to quickly create a simple query such as
+// { "match_bool_prefix": { "message": "quick brown f" } }
 func MatchBoolPrefix(fieldName string, simpleQuery ...interface{}) *MatchQuery {
 	return newMatch(TypeMatchBoolPrefix, fieldName, simpleQuery...)
 }
@@ -96,7 +110,10 @@ func MatchPhrase(fieldName string, simpleQuery ...interface{}) *MatchQuery {
 
 // MatchPhrasePrefix creates a new query of type "match_phrase_prefix" with the
 // provided field name. A comparison value can optionally be provided to quickly
-// create a simple query such as { "match": { "message": "this is a test" } }
+// create a simple query such as
+// { "match_phrase_prefix": { "message": "quick brown f" } }. It is mostly useful
+// for search-as-you-type features, and supports the Slop, MaxExpansions and
+// Analyzer options.
 func MatchPhrasePrefix(fieldName string, simpleQuery ...interface{}) *MatchQuery {
 	return newMatch(TypeMatchPhrasePrefix, fieldName, simpleQuery...)
 }
@@ -202,6 +219,13 @@ func (q *MatchQuery) ZeroTermsQuery(s ZeroTerms) *MatchQuery {
 	return q
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *MatchQuery) Name(name string) *MatchQuery {
+	q.params.Name = name
+	return q
+}
+
 // MatchOperator is an enumeration type representing supported values for a
 // match query's "operator" parameter.
 type MatchOperator uint8
@@ -12,6 +12,7 @@ type MatchAllQuery struct {
 
 type matchAllParams struct {
 	Boost float32 `structs:"boost,omitempty"`
+	Name  string  `structs:"_name,omitempty"`
 }
 
 // Map returns a map representation of the query, thus implementing the
@@ -43,6 +44,13 @@ func (q *MatchAllQuery) Boost(b float32) *MatchAllQuery {
 	return q
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *MatchAllQuery) Name(name string) *MatchAllQuery {
+	q.params.Name = name
+	return q
+}
+
 // MatchNone creates a new query of type "match_none".
 func MatchNone() *MatchAllQuery {
 	return &MatchAllQuery{all: false}
@@ -42,6 +42,25 @@ func TestMatch(t *testing.T) {
 				},
 			},
 		},
+		{
+			"match_bool_prefix with options",
+			MatchBoolPrefix("title", "quick brown f").
+				Operator(OperatorAnd).
+				MinimumShouldMatch("2").
+				Fuzziness("AUTO").
+				Analyzer("standard"),
+			map[string]interface{}{
+				"match_bool_prefix": map[string]interface{}{
+					"title": map[string]interface{}{
+						"query":                "quick brown f",
+						"operator":             "AND",
+						"minimum_should_match": "2",
+						"fuzziness":            "AUTO",
+						"analyzer":             "standard",
+					},
+				},
+			},
+		},
 		{
 			"match_phrase",
 			MatchPhrase("title", "sample text"),
@@ -64,5 +83,73 @@ func TestMatch(t *testing.T) {
 				},
 			},
 		},
+		{
+			"match_phrase_prefix with options",
+			MatchPhrasePrefix("title", "quick brown f").
+				Slop(2).
+				MaxExpansions(10).
+				Analyzer("standard"),
+			map[string]interface{}{
+				"match_phrase_prefix": map[string]interface{}{
+					"title": map[string]interface{}{
+						"query":          "quick brown f",
+						"slop":           2,
+						"max_expansions": 10,
+						"analyzer":       "standard",
+					},
+				},
+			},
+		},
+		{
+			"match_phrase_prefix inside a bool query",
+			Bool().Must(MatchPhrasePrefix("title", "quick brown f").MaxExpansions(5)),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"must": []map[string]interface{}{
+						{
+							"match_phrase_prefix": map[string]interface{}{
+								"title": map[string]interface{}{
+									"query":          "quick brown f",
+									"max_expansions": 5,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"named match query",
+			Match("title", "golang").Name("title_match"),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"title": map[string]interface{}{
+						"query": "golang",
+						"_name": "title_match",
+					},
+				},
+			},
+		},
+		{
+			"short match",
+			Match("message", "this is a test").Short(),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"message": "this is a test",
+				},
+			},
+		},
+		{
+			"short match falls back to verbose form with options",
+			MatchPhrase("message", "this is a test").Slop(2).Short(),
+			map[string]interface{}{
+				"match_phrase": map[string]interface{}{
+					"message": map[string]interface{}{
+						"query": "this is a test",
+						"slop":  2,
+					},
+				},
+			},
+		},
 	})
 }
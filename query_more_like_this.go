@@ -0,0 +1,125 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+// MoreLikeThisQuery represents a query of type "more_like_this", as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-mlt-query.html
+type MoreLikeThisQuery struct {
+	like   []interface{}
+	unlike []interface{}
+	params moreLikeThisParams
+}
+
+type moreLikeThisParams struct {
+	Fields             []string `structs:"fields,omitempty"`
+	MinTermFreq        uint16   `structs:"min_term_freq,omitempty"`
+	MaxQueryTerms      uint16   `structs:"max_query_terms,omitempty"`
+	MinDocFreq         uint16   `structs:"min_doc_freq,omitempty"`
+	MaxDocFreq         uint32   `structs:"max_doc_freq,omitempty"`
+	MinimumShouldMatch string   `structs:"minimum_should_match,omitempty"`
+	Boost              float32  `structs:"boost,omitempty"`
+	Name               string   `structs:"_name,omitempty"`
+}
+
+// MoreLikeThis creates a new query of type "more_like_this" on the provided
+// fields. If no fields are provided, ElasticSearch uses all text fields. The
+// text or documents to find similar documents to are set via the Like method.
+func MoreLikeThis(fields ...string) *MoreLikeThisQuery {
+	return &MoreLikeThisQuery{
+		params: moreLikeThisParams{
+			Fields: fields,
+		},
+	}
+}
+
+// likeValues converts the provided values to their representation in a "like"
+// or "unlike" clause: Mappables such as DocRef are converted to maps, other
+// values such as free text are used as-is.
+func likeValues(vals []interface{}) []interface{} {
+	out := make([]interface{}, len(vals))
+	for i, v := range vals {
+		if m, ok := v.(Mappable); ok {
+			out[i] = m.Map()
+		} else {
+			out[i] = v
+		}
+	}
+	return out
+}
+
+// Like adds free text (strings) or documents (DocRef values) to find similar
+// documents to. Like can be called multiple times, values will be appended to
+// existing ones.
+func (q *MoreLikeThisQuery) Like(vals ...interface{}) *MoreLikeThisQuery {
+	q.like = append(q.like, vals...)
+	return q
+}
+
+// Unlike adds free text or documents whose terms should not be selected when
+// searching for similar documents.
+func (q *MoreLikeThisQuery) Unlike(vals ...interface{}) *MoreLikeThisQuery {
+	q.unlike = append(q.unlike, vals...)
+	return q
+}
+
+// MinTermFreq sets the minimum frequency below which terms of the input are
+// ignored (ElasticSearch defaults to 2).
+func (q *MoreLikeThisQuery) MinTermFreq(n uint16) *MoreLikeThisQuery {
+	q.params.MinTermFreq = n
+	return q
+}
+
+// MaxQueryTerms sets the maximum number of query terms selected (ElasticSearch
+// defaults to 25).
+func (q *MoreLikeThisQuery) MaxQueryTerms(n uint16) *MoreLikeThisQuery {
+	q.params.MaxQueryTerms = n
+	return q
+}
+
+// MinDocFreq sets the minimum number of documents a term must appear in to be
+// selected (ElasticSearch defaults to 5).
+func (q *MoreLikeThisQuery) MinDocFreq(n uint16) *MoreLikeThisQuery {
+	q.params.MinDocFreq = n
+	return q
+}
+
+// MaxDocFreq sets the maximum number of documents a term may appear in to be
+// selected.
+func (q *MoreLikeThisQuery) MaxDocFreq(n uint32) *MoreLikeThisQuery {
+	q.params.MaxDocFreq = n
+	return q
+}
+
+// MinimumShouldMatch sets the number or percentage of selected terms documents
+// must match.
+func (q *MoreLikeThisQuery) MinimumShouldMatch(s string) *MoreLikeThisQuery {
+	q.params.MinimumShouldMatch = s
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *MoreLikeThisQuery) Boost(b float32) *MoreLikeThisQuery {
+	q.params.Boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *MoreLikeThisQuery) Name(name string) *MoreLikeThisQuery {
+	q.params.Name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *MoreLikeThisQuery) Map() map[string]interface{} {
+	inner := structs.Map(q.params)
+	inner["like"] = likeValues(q.like)
+	if len(q.unlike) > 0 {
+		inner["unlike"] = likeValues(q.unlike)
+	}
+
+	return map[string]interface{}{
+		"more_like_this": inner,
+	}
+}
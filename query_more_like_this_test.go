@@ -0,0 +1,52 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestMoreLikeThis(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"more_like_this with text",
+			MoreLikeThis("title", "description").
+				Like("Once upon a time").
+				MinTermFreq(1).
+				MaxQueryTerms(12),
+			map[string]interface{}{
+				"more_like_this": map[string]interface{}{
+					"fields":          []string{"title", "description"},
+					"like":            []interface{}{"Once upon a time"},
+					"min_term_freq":   1,
+					"max_query_terms": 12,
+				},
+			},
+		},
+		{
+			"more_like_this mixing documents and text",
+			MoreLikeThis("title").
+				Like(
+					DocRef{Index: "imdb", ID: "1"},
+					DocRef{ID: "2"},
+					"and potentially some more text here as well",
+				).
+				Unlike(DocRef{Index: "imdb", ID: "3"}).
+				MinDocFreq(2).
+				MinimumShouldMatch("30%"),
+			map[string]interface{}{
+				"more_like_this": map[string]interface{}{
+					"fields": []string{"title"},
+					"like": []interface{}{
+						map[string]interface{}{"_index": "imdb", "_id": "1"},
+						map[string]interface{}{"_id": "2"},
+						"and potentially some more text here as well",
+					},
+					"unlike": []interface{}{
+						map[string]interface{}{"_index": "imdb", "_id": "3"},
+					},
+					"min_doc_freq":         2,
+					"minimum_should_match": "30%",
+				},
+			},
+		},
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/fatih/structs"
 )
 
+// MultiMatchQuery represents a query of type "multi_match", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-multi-match-query.html
 type MultiMatchQuery struct {
 	params multiMatchParams
 }
@@ -34,9 +36,12 @@ type multiMatchParams struct {
 	MinMatch     string         `structs:"minimum_should_match,omitempty"`
 	ZeroTerms    ZeroTerms      `structs:"zero_terms_query,string,omitempty"`
 	Slp          uint16         `structs:"slop,omitempty"`
+	Name         string         `structs:"_name,omitempty"`
 }
 
-// MultiMatch creates a new query of type "multi_match"
+// MultiMatch creates a new query of type "multi_match". The query text can
+// either be provided here or via the Query method, and the fields to search
+// via the Fields method.
 func MultiMatch(simpleQuery ...interface{}) *MultiMatchQuery {
 	return newMultiMatch(simpleQuery...)
 }
@@ -68,7 +73,8 @@ func (q *MultiMatchQuery) Analyzer(a string) *MultiMatchQuery {
 	return q
 }
 
-// Fields sets the fields used in the query
+// Fields adds fields to the query. Individual fields can be boosted with the
+// caret notation, e.g. "subject^3".
 func (q *MultiMatchQuery) Fields(a ...string) *MultiMatchQuery {
 	q.params.Fields = append(q.params.Fields, a...)
 	return q
@@ -100,13 +106,14 @@ func (q *MultiMatchQuery) PrefixLength(l uint16) *MultiMatchQuery {
 	return q
 }
 
-// TieBreaker
+// TieBreaker sets a value by which the scores of the fields that did not
+// produce the best score are multiplied before being added to it.
 func (q *MultiMatchQuery) TieBreaker(l float32) *MultiMatchQuery {
 	q.params.TieBrk = l
 	return q
 }
 
-// Boost
+// Boost sets the boost value of the query.
 func (q *MultiMatchQuery) Boost(l float32) *MultiMatchQuery {
 	q.params.Boost = l
 	return q
@@ -137,7 +144,9 @@ func (q *MultiMatchQuery) Operator(op MatchOperator) *MultiMatchQuery {
 	return q
 }
 
-// Type sets the query type
+// Type sets the query type, which determines how the query is executed and
+// scored. Since "best_fields" is ElasticSearch's default, it is never
+// emitted.
 func (q *MultiMatchQuery) Type(t MultiMatchType) *MultiMatchQuery {
 	q.params.Type = t
 	return q
@@ -164,32 +173,39 @@ func (q *MultiMatchQuery) ZeroTermsQuery(s ZeroTerms) *MultiMatchQuery {
 	return q
 }
 
-// MatchType is an enumeration type representing supported values for a
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *MultiMatchQuery) Name(name string) *MultiMatchQuery {
+	q.params.Name = name
+	return q
+}
+
+// MultiMatchType is an enumeration type representing supported values for a
 // multi match query's "type" parameter.
 type MultiMatchType uint8
 
 const (
-	// TypeBestFields is the "best_fields" type
+	// MatchTypeBestFields is the "best_fields" type
 	MatchTypeBestFields MultiMatchType = iota
 
-	// TypeMostFields is the "most_fields" type
+	// MatchTypeMostFields is the "most_fields" type
 	MatchTypeMostFields
 
-	// TypeMostFields is the "cross_fields" type
+	// MatchTypeCrossFields is the "cross_fields" type
 	MatchTypeCrossFields
 
-	// TypeMostFields is the "phrase" type
+	// MatchTypePhrase is the "phrase" type
 	MatchTypePhrase
 
-	// TypeMostFields is the "phrase_prefix" type
+	// MatchTypePhrasePrefix is the "phrase_prefix" type
 	MatchTypePhrasePrefix
 
-	// TypeMostFields is the "bool_prefix" type
+	// MatchTypeBoolPrefix is the "bool_prefix" type
 	MatchTypeBoolPrefix
 )
 
-// String returns a string representation of the match operator, as known to
-// ElasticSearch.
+// String returns a string representation of the multi match type, as known
+// to ElasticSearch.
 func (a MultiMatchType) String() string {
 	switch a {
 	case MatchTypeBestFields:
@@ -58,5 +58,20 @@ func TestMultiMatch(t *testing.T) {
 				},
 			},
 		},
+		{
+			"multi_match with boosted fields",
+			MultiMatch("quick brown fox").
+				Fields("subject^3", "message").
+				Type(MatchTypeCrossFields).
+				Operator(OperatorAnd),
+			map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query":    "quick brown fox",
+					"fields":   []string{"subject^3", "message"},
+					"type":     "cross_fields",
+					"operator": "AND",
+				},
+			},
+		},
 	})
 }
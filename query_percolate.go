@@ -0,0 +1,59 @@
+package elasticsearch
+
+// PercolateQuery represents a query of type "percolate", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-percolate-query.html
+type PercolateQuery struct {
+	field    string
+	document map[string]interface{}
+	index    string
+	id       string
+	name     string
+}
+
+// Percolate creates a new query of type "percolate", matching the queries
+// stored in the provided percolator field against the provided document.
+func Percolate(field string, document map[string]interface{}) *PercolateQuery {
+	return &PercolateQuery{
+		field:    field,
+		document: document,
+	}
+}
+
+// PercolateExisting is the same as Percolate, except that the document
+// matched against the stored queries is the existing document with the
+// provided index and ID.
+func PercolateExisting(field, index, id string) *PercolateQuery {
+	return &PercolateQuery{
+		field: field,
+		index: index,
+		id:    id,
+	}
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *PercolateQuery) Name(name string) *PercolateQuery {
+	q.name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *PercolateQuery) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field": q.field,
+	}
+	if q.document != nil {
+		innerMap["document"] = q.document
+	} else {
+		innerMap["index"] = q.index
+		innerMap["id"] = q.id
+	}
+	if q.name != "" {
+		innerMap["_name"] = q.name
+	}
+
+	return map[string]interface{}{
+		"percolate": innerMap,
+	}
+}
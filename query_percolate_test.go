@@ -0,0 +1,36 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestPercolate(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"percolate a document",
+			Percolate("query", map[string]interface{}{
+				"message": "A new bonsai tree in the office",
+			}),
+			map[string]interface{}{
+				"percolate": map[string]interface{}{
+					"field": "query",
+					"document": map[string]interface{}{
+						"message": "A new bonsai tree in the office",
+					},
+				},
+			},
+		},
+		{
+			"percolate an existing document",
+			PercolateExisting("query", "my-index", "2").Name("alerts"),
+			map[string]interface{}{
+				"percolate": map[string]interface{}{
+					"field": "query",
+					"index": "my-index",
+					"id":    "2",
+					"_name": "alerts",
+				},
+			},
+		},
+	})
+}
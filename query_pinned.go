@@ -0,0 +1,77 @@
+package elasticsearch
+
+import "errors"
+
+// PinnedQuery represents a query of type "pinned", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-pinned-query.html
+type PinnedQuery struct {
+	ids     []string
+	docs    []DocRef
+	organic Mappable
+}
+
+// Pinned creates a new query of type "pinned", promoting the documents with the
+// provided IDs above the results of the provided organic query. Pinned
+// documents are returned in the order of their IDs.
+func Pinned(ids []string, organic Mappable) *PinnedQuery {
+	return &PinnedQuery{
+		ids:     ids,
+		organic: organic,
+	}
+}
+
+// PinnedDocs is the same as Pinned, except that documents are referenced by
+// index and ID, which is needed when searching multiple indices.
+func PinnedDocs(docs []DocRef, organic Mappable) *PinnedQuery {
+	return &PinnedQuery{
+		docs:    docs,
+		organic: organic,
+	}
+}
+
+// IDs adds IDs of documents to pin.
+func (q *PinnedQuery) IDs(ids ...string) *PinnedQuery {
+	q.ids = append(q.ids, ids...)
+	return q
+}
+
+// Docs adds references to documents to pin.
+func (q *PinnedQuery) Docs(docs ...DocRef) *PinnedQuery {
+	q.docs = append(q.docs, docs...)
+	return q
+}
+
+// Validate checks that the query pins documents either by ID or by reference,
+// as ElasticSearch rejects queries setting both or neither.
+func (q *PinnedQuery) Validate() error {
+	if len(q.ids) > 0 && len(q.docs) > 0 {
+		return errors.New("pinned query cannot set both ids and docs")
+	}
+	if len(q.ids) == 0 && len(q.docs) == 0 {
+		return errors.New("pinned query must set either ids or docs")
+	}
+
+	return nil
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface. The query is not validated; use Validate for that.
+func (q *PinnedQuery) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"organic": q.organic.Map(),
+	}
+	if len(q.ids) > 0 {
+		innerMap["ids"] = q.ids
+	}
+	if len(q.docs) > 0 {
+		docs := make([]map[string]interface{}, len(q.docs))
+		for i, doc := range q.docs {
+			docs[i] = doc.Map()
+		}
+		innerMap["docs"] = docs
+	}
+
+	return map[string]interface{}{
+		"pinned": innerMap,
+	}
+}
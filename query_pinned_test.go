@@ -0,0 +1,53 @@
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestPinned(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"pinned by ids",
+			Pinned([]string{"1", "2"}, Match("description", "iphone")),
+			map[string]interface{}{
+				"pinned": map[string]interface{}{
+					"ids": []string{"1", "2"},
+					"organic": map[string]interface{}{
+						"match": map[string]interface{}{
+							"description": map[string]interface{}{
+								"query": "iphone",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"pinned by docs",
+			PinnedDocs(
+				[]DocRef{{Index: "products", ID: "1"}},
+				MatchAll(),
+			).Docs(DocRef{Index: "promotions", ID: "4"}),
+			map[string]interface{}{
+				"pinned": map[string]interface{}{
+					"docs": []map[string]interface{}{
+						{"_index": "products", "_id": "1"},
+						{"_index": "promotions", "_id": "4"},
+					},
+					"organic": map[string]interface{}{
+						"match_all": map[string]interface{}{},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestPinnedValidate(t *testing.T) {
+	assert.Nil(t, Pinned([]string{"1"}, MatchAll()).Validate())
+	assert.Nil(t, PinnedDocs([]DocRef{{ID: "1"}}, MatchAll()).Validate())
+	assert.NotNil(t, Pinned(nil, MatchAll()).Validate())
+	assert.NotNil(t, Pinned([]string{"1"}, MatchAll()).Docs(DocRef{ID: "2"}).Validate())
+}
@@ -0,0 +1,93 @@
+package elasticsearch
+
+// RankFeatureQuery represents a query of type "rank_feature", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-rank-feature-query.html
+type RankFeatureQuery struct {
+	field    string
+	function string
+	params   map[string]interface{}
+	boost    float32
+	name     string
+}
+
+// RankFeature creates a new query of type "rank_feature", boosting the score
+// of documents based on the value of the provided rank_feature or
+// rank_features field. The function used to compute the score can be set via
+// the Saturation, Log, Sigmoid and Linear methods; these are mutually
+// exclusive, so only the last one set is used. ElasticSearch defaults to the
+// saturation function.
+func RankFeature(field string) *RankFeatureQuery {
+	return &RankFeatureQuery{
+		field: field,
+	}
+}
+
+// Saturation sets the "saturation" function, with the provided pivot value.
+func (q *RankFeatureQuery) Saturation(pivot float64) *RankFeatureQuery {
+	q.function = "saturation"
+	q.params = map[string]interface{}{
+		"pivot": pivot,
+	}
+	return q
+}
+
+// Log sets the "log" function, with the provided scaling factor.
+func (q *RankFeatureQuery) Log(scalingFactor float64) *RankFeatureQuery {
+	q.function = "log"
+	q.params = map[string]interface{}{
+		"scaling_factor": scalingFactor,
+	}
+	return q
+}
+
+// Sigmoid sets the "sigmoid" function, with the provided pivot value and
+// exponent.
+func (q *RankFeatureQuery) Sigmoid(pivot, exponent float64) *RankFeatureQuery {
+	q.function = "sigmoid"
+	q.params = map[string]interface{}{
+		"pivot":    pivot,
+		"exponent": exponent,
+	}
+	return q
+}
+
+// Linear sets the "linear" function.
+func (q *RankFeatureQuery) Linear() *RankFeatureQuery {
+	q.function = "linear"
+	q.params = map[string]interface{}{}
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *RankFeatureQuery) Boost(b float32) *RankFeatureQuery {
+	q.boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *RankFeatureQuery) Name(name string) *RankFeatureQuery {
+	q.name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *RankFeatureQuery) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"field": q.field,
+	}
+	if q.function != "" {
+		innerMap[q.function] = q.params
+	}
+	if q.boost != 0 {
+		innerMap["boost"] = q.boost
+	}
+	if q.name != "" {
+		innerMap["_name"] = q.name
+	}
+
+	return map[string]interface{}{
+		"rank_feature": innerMap,
+	}
+}
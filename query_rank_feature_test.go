@@ -0,0 +1,68 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestRankFeature(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"rank_feature with default function",
+			RankFeature("pagerank"),
+			map[string]interface{}{
+				"rank_feature": map[string]interface{}{
+					"field": "pagerank",
+				},
+			},
+		},
+		{
+			"rank_feature with saturation",
+			RankFeature("pagerank").Saturation(8).Boost(2),
+			map[string]interface{}{
+				"rank_feature": map[string]interface{}{
+					"field": "pagerank",
+					"saturation": map[string]interface{}{
+						"pivot": 8,
+					},
+					"boost": 2,
+				},
+			},
+		},
+		{
+			"rank_feature with log",
+			RankFeature("pagerank").Log(4),
+			map[string]interface{}{
+				"rank_feature": map[string]interface{}{
+					"field": "pagerank",
+					"log": map[string]interface{}{
+						"scaling_factor": 4,
+					},
+				},
+			},
+		},
+		{
+			"rank_feature with sigmoid",
+			RankFeature("pagerank").Sigmoid(7, 0.6),
+			map[string]interface{}{
+				"rank_feature": map[string]interface{}{
+					"field": "pagerank",
+					"sigmoid": map[string]interface{}{
+						"pivot":    7,
+						"exponent": 0.6,
+					},
+				},
+			},
+		},
+		{
+			"rank_feature keeps the last function set",
+			RankFeature("topics.sports").Saturation(8).Linear().Name("sports"),
+			map[string]interface{}{
+				"rank_feature": map[string]interface{}{
+					"field":  "topics.sports",
+					"linear": map[string]interface{}{},
+					"_name":  "sports",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+package elasticsearch
+
+// ScriptedQuery represents a query of type "script", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-script-query.html
+type ScriptedQuery struct {
+	script *Script
+	name   string
+}
+
+// ScriptQuery creates a new query of type "script" with the provided script.
+// The script must return a boolean value. Script queries are typically used in
+// filter context.
+func ScriptQuery(script *Script) *ScriptedQuery {
+	return &ScriptedQuery{script: script}
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *ScriptedQuery) Name(name string) *ScriptedQuery {
+	q.name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *ScriptedQuery) Map() map[string]interface{} {
+	inner := map[string]interface{}{
+		"script": q.script.Map(),
+	}
+	if q.name != "" {
+		inner["_name"] = q.name
+	}
+
+	return map[string]interface{}{
+		"script": inner,
+	}
+}
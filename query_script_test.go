@@ -0,0 +1,62 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestScriptQuery(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"inline script with params",
+			ScriptQuery(
+				ScriptSource("doc['num1'].value > params.param1").
+					Lang("painless").
+					Params(map[string]interface{}{"param1": 5}),
+			),
+			map[string]interface{}{
+				"script": map[string]interface{}{
+					"script": map[string]interface{}{
+						"source": "doc['num1'].value > params.param1",
+						"lang":   "painless",
+						"params": map[string]interface{}{
+							"param1": 5,
+						},
+					},
+				},
+			},
+		},
+		{
+			"stored script",
+			ScriptQuery(ScriptID("my-filter")).Name("scripted"),
+			map[string]interface{}{
+				"script": map[string]interface{}{
+					"script": map[string]interface{}{
+						"id": "my-filter",
+					},
+					"_name": "scripted",
+				},
+			},
+		},
+		{
+			"script in a script_score function",
+			FunctionScore(MatchAll()).
+				AddFunction(ScriptScore(ScriptSource("_score * 2"))),
+			map[string]interface{}{
+				"function_score": map[string]interface{}{
+					"query": map[string]interface{}{
+						"match_all": map[string]interface{}{},
+					},
+					"functions": []map[string]interface{}{
+						{
+							"script_score": map[string]interface{}{
+								"script": map[string]interface{}{
+									"source": "_score * 2",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
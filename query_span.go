@@ -0,0 +1,190 @@
+package elasticsearch
+
+// SpanQuery is the interface implemented by span queries, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/span-queries.html
+// Compound span queries only accept other span queries as clauses, which is
+// enforced at compile time by only implementing this interface for the span
+// query types of this package.
+type SpanQuery interface {
+	Mappable
+	spanQuery()
+}
+
+// spanClauses returns the map representations of the provided span queries,
+// as expected under a "clauses" key.
+func spanClauses(clauses []SpanQuery) []map[string]interface{} {
+	maps := make([]map[string]interface{}, len(clauses))
+	for i, clause := range clauses {
+		maps[i] = clause.Map()
+	}
+	return maps
+}
+
+//----------------------------------------------------------------------------//
+
+// SpanTermQuery represents a query of type "span_term", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-span-term-query.html
+type SpanTermQuery struct {
+	field string
+	value string
+	boost float32
+}
+
+// SpanTerm creates a new query of type "span_term", matching spans containing
+// the provided term.
+func SpanTerm(field, value string) *SpanTermQuery {
+	return &SpanTermQuery{
+		field: field,
+		value: value,
+	}
+}
+
+func (q *SpanTermQuery) spanQuery() {}
+
+// Boost sets the boost value of the query.
+func (q *SpanTermQuery) Boost(b float32) *SpanTermQuery {
+	q.boost = b
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *SpanTermQuery) Map() map[string]interface{} {
+	innerMap := map[string]interface{}{
+		"value": q.value,
+	}
+	if q.boost != 0 {
+		innerMap["boost"] = q.boost
+	}
+
+	return map[string]interface{}{
+		"span_term": map[string]interface{}{
+			q.field: innerMap,
+		},
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// SpanNearQuery represents a query of type "span_near", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-span-near-query.html
+type SpanNearQuery struct {
+	clauses []SpanQuery
+	slop    int
+	inOrder bool
+}
+
+// SpanNear creates a new query of type "span_near", matching spans of the
+// provided clauses that are at most slop positions apart, and in the order of
+// the clauses if inOrder is true.
+func SpanNear(clauses []SpanQuery, slop int, inOrder bool) *SpanNearQuery {
+	return &SpanNearQuery{
+		clauses: clauses,
+		slop:    slop,
+		inOrder: inOrder,
+	}
+}
+
+func (q *SpanNearQuery) spanQuery() {}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *SpanNearQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"span_near": map[string]interface{}{
+			"clauses":  spanClauses(q.clauses),
+			"slop":     q.slop,
+			"in_order": q.inOrder,
+		},
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// SpanOrQuery represents a query of type "span_or", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-span-or-query.html
+type SpanOrQuery struct {
+	clauses []SpanQuery
+}
+
+// SpanOr creates a new query of type "span_or", matching the union of the
+// spans of the provided clauses.
+func SpanOr(clauses ...SpanQuery) *SpanOrQuery {
+	return &SpanOrQuery{
+		clauses: clauses,
+	}
+}
+
+func (q *SpanOrQuery) spanQuery() {}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *SpanOrQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"span_or": map[string]interface{}{
+			"clauses": spanClauses(q.clauses),
+		},
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// SpanNotQuery represents a query of type "span_not", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-span-not-query.html
+type SpanNotQuery struct {
+	include SpanQuery
+	exclude SpanQuery
+}
+
+// SpanNot creates a new query of type "span_not", matching the spans of the
+// include query that do not overlap with spans of the exclude query.
+func SpanNot(include, exclude SpanQuery) *SpanNotQuery {
+	return &SpanNotQuery{
+		include: include,
+		exclude: exclude,
+	}
+}
+
+func (q *SpanNotQuery) spanQuery() {}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *SpanNotQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"span_not": map[string]interface{}{
+			"include": q.include.Map(),
+			"exclude": q.exclude.Map(),
+		},
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// SpanFirstQuery represents a query of type "span_first", as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-span-first-query.html
+type SpanFirstQuery struct {
+	match SpanQuery
+	end   int
+}
+
+// SpanFirst creates a new query of type "span_first", matching spans of the
+// provided query that end at most at the provided position of the field.
+func SpanFirst(match SpanQuery, end int) *SpanFirstQuery {
+	return &SpanFirstQuery{
+		match: match,
+		end:   end,
+	}
+}
+
+func (q *SpanFirstQuery) spanQuery() {}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *SpanFirstQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"span_first": map[string]interface{}{
+			"match": q.match.Map(),
+			"end":   q.end,
+		},
+	}
+}
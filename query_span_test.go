@@ -0,0 +1,106 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestSpanQueries(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"span_term",
+			SpanTerm("user", "kimchy").Boost(2),
+			map[string]interface{}{
+				"span_term": map[string]interface{}{
+					"user": map[string]interface{}{
+						"value": "kimchy",
+						"boost": 2,
+					},
+				},
+			},
+		},
+		{
+			"span_near with nested span_or",
+			SpanNear([]SpanQuery{
+				SpanTerm("field", "value1"),
+				SpanOr(SpanTerm("field", "value2"), SpanTerm("field", "value3")),
+			}, 12, false),
+			map[string]interface{}{
+				"span_near": map[string]interface{}{
+					"clauses": []map[string]interface{}{
+						{
+							"span_term": map[string]interface{}{
+								"field": map[string]interface{}{"value": "value1"},
+							},
+						},
+						{
+							"span_or": map[string]interface{}{
+								"clauses": []map[string]interface{}{
+									{
+										"span_term": map[string]interface{}{
+											"field": map[string]interface{}{"value": "value2"},
+										},
+									},
+									{
+										"span_term": map[string]interface{}{
+											"field": map[string]interface{}{"value": "value3"},
+										},
+									},
+								},
+							},
+						},
+					},
+					"slop":     12,
+					"in_order": false,
+				},
+			},
+		},
+		{
+			"span_not",
+			SpanNot(
+				SpanNear([]SpanQuery{SpanTerm("field1", "hoya"), SpanTerm("field1", "la")}, 0, true),
+				SpanTerm("field1", "palabra"),
+			),
+			map[string]interface{}{
+				"span_not": map[string]interface{}{
+					"include": map[string]interface{}{
+						"span_near": map[string]interface{}{
+							"clauses": []map[string]interface{}{
+								{
+									"span_term": map[string]interface{}{
+										"field1": map[string]interface{}{"value": "hoya"},
+									},
+								},
+								{
+									"span_term": map[string]interface{}{
+										"field1": map[string]interface{}{"value": "la"},
+									},
+								},
+							},
+							"slop":     0,
+							"in_order": true,
+						},
+					},
+					"exclude": map[string]interface{}{
+						"span_term": map[string]interface{}{
+							"field1": map[string]interface{}{"value": "palabra"},
+						},
+					},
+				},
+			},
+		},
+		{
+			"span_first",
+			SpanFirst(SpanTerm("user", "kimchy"), 3),
+			map[string]interface{}{
+				"span_first": map[string]interface{}{
+					"match": map[string]interface{}{
+						"span_term": map[string]interface{}{
+							"user": map[string]interface{}{"value": "kimchy"},
+						},
+					},
+					"end": 3,
+				},
+			},
+		},
+	})
+}
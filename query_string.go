@@ -0,0 +1,204 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+// QueryStringQuery represents a query of type "query_string", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-query-string-query.html
+type QueryStringQuery struct {
+	params queryStringParams
+}
+
+type queryStringParams struct {
+	Query                string        `structs:"query"`
+	DefaultField         string        `structs:"default_field,omitempty"`
+	Fields               []string      `structs:"fields,omitempty"`
+	DefaultOperator      MatchOperator `structs:"default_operator,string,omitempty"`
+	Analyzer             string        `structs:"analyzer,omitempty"`
+	AllowLeadingWildcard *bool         `structs:"allow_leading_wildcard,omitempty"`
+	AnalyzeWildcard      *bool         `structs:"analyze_wildcard,omitempty"`
+	Lenient              *bool         `structs:"lenient,omitempty"`
+	Boost                float32       `structs:"boost,omitempty"`
+	Name                 string        `structs:"_name,omitempty"`
+}
+
+// QueryString creates a new query of type "query_string" with the provided
+// query text, written in Lucene's query string syntax. The text is passed to
+// ElasticSearch as-is, without any escaping; since invalid syntax results in
+// an error, SimpleQueryString is usually better suited for user input.
+func QueryString(query string) *QueryStringQuery {
+	return &QueryStringQuery{
+		params: queryStringParams{
+			Query: query,
+		},
+	}
+}
+
+// DefaultField sets the field to search when no field is provided in the
+// query text.
+func (q *QueryStringQuery) DefaultField(field string) *QueryStringQuery {
+	q.params.DefaultField = field
+	return q
+}
+
+// Fields sets the fields to search when no field is provided in the query
+// text. Individual fields can be boosted with the caret notation, e.g.
+// "subject^3".
+func (q *QueryStringQuery) Fields(fields ...string) *QueryStringQuery {
+	q.params.Fields = fields
+	return q
+}
+
+// DefaultOperator sets the boolean logic used to interpret terms in the query
+// text when no operator is specified.
+func (q *QueryStringQuery) DefaultOperator(op MatchOperator) *QueryStringQuery {
+	q.params.DefaultOperator = op
+	return q
+}
+
+// Analyzer sets the analyzer used to convert the query text into tokens.
+func (q *QueryStringQuery) Analyzer(a string) *QueryStringQuery {
+	q.params.Analyzer = a
+	return q
+}
+
+// AllowLeadingWildcard sets whether "*" and "?" are allowed as the first
+// character of a term.
+func (q *QueryStringQuery) AllowLeadingWildcard(b bool) *QueryStringQuery {
+	q.params.AllowLeadingWildcard = &b
+	return q
+}
+
+// AnalyzeWildcard sets whether wildcard terms are analyzed.
+func (q *QueryStringQuery) AnalyzeWildcard(b bool) *QueryStringQuery {
+	q.params.AnalyzeWildcard = &b
+	return q
+}
+
+// Lenient sets whether format-based errors should be ignored.
+func (q *QueryStringQuery) Lenient(b bool) *QueryStringQuery {
+	q.params.Lenient = &b
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *QueryStringQuery) Boost(b float32) *QueryStringQuery {
+	q.params.Boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *QueryStringQuery) Name(name string) *QueryStringQuery {
+	q.params.Name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *QueryStringQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"query_string": structs.Map(q.params),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// SimpleQueryStringQuery represents a query of type "simple_query_string", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-simple-query-string-query.html
+type SimpleQueryStringQuery struct {
+	params simpleQueryStringParams
+}
+
+type simpleQueryStringParams struct {
+	Query              string        `structs:"query"`
+	Fields             []string      `structs:"fields,omitempty"`
+	DefaultOperator    MatchOperator `structs:"default_operator,string,omitempty"`
+	Flags              string        `structs:"flags,omitempty"`
+	AnalyzeWildcard    *bool         `structs:"analyze_wildcard,omitempty"`
+	MinimumShouldMatch string        `structs:"minimum_should_match,omitempty"`
+	Analyzer           string        `structs:"analyzer,omitempty"`
+	Lenient            *bool         `structs:"lenient,omitempty"`
+	Boost              float32       `structs:"boost,omitempty"`
+	Name               string        `structs:"_name,omitempty"`
+}
+
+// SimpleQueryString creates a new query of type "simple_query_string" with the
+// provided query text. Unlike QueryString, invalid syntax in the text is
+// ignored rather than resulting in an error, which makes it suitable for user
+// input.
+func SimpleQueryString(query string) *SimpleQueryStringQuery {
+	return &SimpleQueryStringQuery{
+		params: simpleQueryStringParams{
+			Query: query,
+		},
+	}
+}
+
+// Fields sets the fields to search. Individual fields can be boosted with the
+// caret notation, e.g. "subject^3".
+func (q *SimpleQueryStringQuery) Fields(fields ...string) *SimpleQueryStringQuery {
+	q.params.Fields = fields
+	return q
+}
+
+// DefaultOperator sets the boolean logic used to interpret terms in the query
+// text when no operator is specified.
+func (q *SimpleQueryStringQuery) DefaultOperator(op MatchOperator) *SimpleQueryStringQuery {
+	q.params.DefaultOperator = op
+	return q
+}
+
+// Flags sets the operators enabled in the query text, separated by "|", e.g.
+// "AND|OR|PREFIX". Supported values are ALL, NONE, AND, ESCAPE, FUZZY, NEAR,
+// NOT, OR, PHRASE, PRECEDENCE, PREFIX, SLOP and WHITESPACE.
+func (q *SimpleQueryStringQuery) Flags(flags string) *SimpleQueryStringQuery {
+	q.params.Flags = flags
+	return q
+}
+
+// AnalyzeWildcard sets whether prefix terms are analyzed.
+func (q *SimpleQueryStringQuery) AnalyzeWildcard(b bool) *SimpleQueryStringQuery {
+	q.params.AnalyzeWildcard = &b
+	return q
+}
+
+// MinimumShouldMatch sets the minimum number of clauses that must match for a
+// document to be returned.
+func (q *SimpleQueryStringQuery) MinimumShouldMatch(s string) *SimpleQueryStringQuery {
+	q.params.MinimumShouldMatch = s
+	return q
+}
+
+// Analyzer sets the analyzer used to convert the query text into tokens.
+func (q *SimpleQueryStringQuery) Analyzer(a string) *SimpleQueryStringQuery {
+	q.params.Analyzer = a
+	return q
+}
+
+// Lenient sets whether format-based errors should be ignored.
+func (q *SimpleQueryStringQuery) Lenient(b bool) *SimpleQueryStringQuery {
+	q.params.Lenient = &b
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *SimpleQueryStringQuery) Boost(b float32) *SimpleQueryStringQuery {
+	q.params.Boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *SimpleQueryStringQuery) Name(name string) *SimpleQueryStringQuery {
+	q.params.Name = name
+	return q
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface.
+func (q *SimpleQueryStringQuery) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"simple_query_string": structs.Map(q.params),
+	}
+}
@@ -0,0 +1,58 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestQueryString(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"query_string: simple",
+			QueryString("(new york city) OR (big apple)").DefaultField("content"),
+			map[string]interface{}{
+				"query_string": map[string]interface{}{
+					"query":         "(new york city) OR (big apple)",
+					"default_field": "content",
+				},
+			},
+		},
+		{
+			"query_string: raw text is not escaped",
+			QueryString(`title:"quick fox" AND status:(active OR pending) -user:"bob\"s"`).
+				Fields("title^2", "body").
+				DefaultOperator(OperatorAnd).
+				Analyzer("standard").
+				AllowLeadingWildcard(false).
+				Lenient(true),
+			map[string]interface{}{
+				"query_string": map[string]interface{}{
+					"query":                  `title:"quick fox" AND status:(active OR pending) -user:"bob\"s"`,
+					"fields":                 []string{"title^2", "body"},
+					"default_operator":       "AND",
+					"analyzer":               "standard",
+					"allow_leading_wildcard": false,
+					"lenient":                true,
+				},
+			},
+		},
+		{
+			"simple_query_string: restricted operators",
+			SimpleQueryString(`"fried eggs" +(eggplant | potato) -frittata`).
+				Fields("title^5", "body").
+				DefaultOperator(OperatorAnd).
+				Flags("OR|AND|PREFIX").
+				AnalyzeWildcard(true).
+				MinimumShouldMatch("2"),
+			map[string]interface{}{
+				"simple_query_string": map[string]interface{}{
+					"query":                `"fried eggs" +(eggplant | potato) -frittata`,
+					"fields":               []string{"title^5", "body"},
+					"default_operator":     "AND",
+					"flags":                "OR|AND|PREFIX",
+					"analyze_wildcard":     true,
+					"minimum_should_match": "2",
+				},
+			},
+		},
+	})
+}
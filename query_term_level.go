@@ -9,11 +9,21 @@ import (
 type ExistsQuery struct {
 	// Field is the name of the field to check for existence
 	Field string `structs:"field"`
+
+	// QueryName is the name of the query, see Name
+	QueryName string `structs:"_name,omitempty"`
 }
 
 // Exists creates a new query of type "exists" on the provided field.
 func Exists(field string) *ExistsQuery {
-	return &ExistsQuery{field}
+	return &ExistsQuery{Field: field}
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *ExistsQuery) Name(name string) *ExistsQuery {
+	q.QueryName = name
+	return q
 }
 
 // Map returns a map representation of the query, thus implementing the
@@ -33,6 +43,9 @@ type IDsQuery struct {
 	IDs struct {
 		// Values is the list of ID values
 		Values []string `structs:"values"`
+
+		// QueryName is the name of the query, see Name
+		QueryName string `structs:"_name,omitempty"`
 	} `structs:"ids"`
 }
 
@@ -43,6 +56,19 @@ func IDs(vals ...string) *IDsQuery {
 	return q
 }
 
+// Add appends the provided values to the query's ID values.
+func (q *IDsQuery) Add(vals ...string) *IDsQuery {
+	q.IDs.Values = append(q.IDs.Values, vals...)
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *IDsQuery) Name(name string) *IDsQuery {
+	q.IDs.QueryName = name
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q *IDsQuery) Map() map[string]interface{} {
@@ -55,6 +81,7 @@ func (q *IDsQuery) Map() map[string]interface{} {
 // https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-prefix-query.html
 type PrefixQuery struct {
 	field  string
+	short  bool
 	params prefixQueryParams
 }
 
@@ -64,10 +91,20 @@ type prefixQueryParams struct {
 
 	// Rewrite is the method used to rewrite the query
 	Rewrite string `structs:"rewrite,omitempty"`
+
+	// CaseInsensitive denotes whether the value is matched case insensitively
+	CaseInsensitive bool `structs:"case_insensitive,omitempty"`
+
+	// Boost is the boost value of the query
+	Boost float32 `structs:"boost,omitempty"`
+
+	// Name is the name of the query
+	Name string `structs:"_name,omitempty"`
 }
 
 // Prefix creates a new query of type "prefix", on the provided field and using
-// the provided prefix value.
+// the provided prefix value. Unlike MatchPhrasePrefix, the value is not
+// analyzed, which makes it suitable for keyword fields.
 func Prefix(field, value string) *PrefixQuery {
 	return &PrefixQuery{
 		field:  field,
@@ -81,12 +118,40 @@ func (q *PrefixQuery) Rewrite(s string) *PrefixQuery {
 	return q
 }
 
+// CaseInsensitive sets whether the value is matched case insensitively. It is
+// only emitted when true, as it requires ElasticSearch 7.10 or newer.
+func (q *PrefixQuery) CaseInsensitive(b bool) *PrefixQuery {
+	q.params.CaseInsensitive = b
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *PrefixQuery) Boost(b float32) *PrefixQuery {
+	q.params.Boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *PrefixQuery) Name(name string) *PrefixQuery {
+	q.params.Name = name
+	return q
+}
+
+// Short generates {"prefix": {"user": "ki"}} rather than nesting the prefix
+// under "value". The verbose form is kept if Rewrite, CaseInsensitive, Boost or
+// Name is set.
+func (q *PrefixQuery) Short() *PrefixQuery {
+	q.short = true
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q *PrefixQuery) Map() map[string]interface{} {
 	return map[string]interface{}{
 		"prefix": map[string]interface{}{
-			q.field: structs.Map(q.params),
+			q.field: leafParams(structs.Map(q.params), "value", q.short),
 		},
 	}
 }
@@ -109,9 +174,13 @@ type rangeQueryParams struct {
 	Relation RangeRelation `structs:"relation,string,omitempty"`
 	TimeZone string        `structs:"time_zone,omitempty"`
 	Boost    float32       `structs:"boost,omitempty"`
+	Name     string        `structs:"_name,omitempty"`
 }
 
-// Range creates a new query of type "range" on the provided field
+// Range creates a new query of type "range" on the provided field. Bounds can
+// be of any type, and are passed to ElasticSearch as-is, so date math
+// expressions such as "now-1d/d" can be used for date fields. Only the bounds
+// that have been set are included in the query.
 func Range(field string) *RangeQuery {
 	return &RangeQuery{field: field}
 }
@@ -122,7 +191,7 @@ func (a *RangeQuery) Gt(val interface{}) *RangeQuery {
 	return a
 }
 
-// Gt sets that the value of field must be greater than or equal to the provided
+// Gte sets that the value of field must be greater than or equal to the provided
 // value
 func (a *RangeQuery) Gte(val interface{}) *RangeQuery {
 	a.params.Gte = val
@@ -166,6 +235,13 @@ func (a *RangeQuery) Boost(b float32) *RangeQuery {
 	return a
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (a *RangeQuery) Name(name string) *RangeQuery {
+	a.params.Name = name
+	return a
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (a *RangeQuery) Map() map[string]interface{} {
@@ -214,14 +290,18 @@ func (a RangeRelation) String() string {
 type RegexpQuery struct {
 	field    string
 	wildcard bool
+	short    bool
 	params   regexpQueryParams
 }
 
 type regexpQueryParams struct {
-	Value                 string `structs:"value"`
-	Flags                 string `structs:"flags,omitempty"`
-	MaxDeterminizedStates uint16 `structs:"max_determinized_states,omitempty"`
-	Rewrite               string `structs:"rewrite,omitempty"`
+	Value                 string  `structs:"value"`
+	Flags                 string  `structs:"flags,omitempty"`
+	MaxDeterminizedStates uint16  `structs:"max_determinized_states,omitempty"`
+	Rewrite               string  `structs:"rewrite,omitempty"`
+	CaseInsensitive       bool    `structs:"case_insensitive,omitempty"`
+	Boost                 float32 `structs:"boost,omitempty"`
+	Name                  string  `structs:"_name,omitempty"`
 }
 
 // Regexp creates a new query of type "regexp" on the provided field and using
@@ -241,7 +321,10 @@ func (q *RegexpQuery) Value(v string) *RegexpQuery {
 	return q
 }
 
-// Flags sets the regular expression's optional flags.
+// Flags sets the regular expression's optional operators, separated by "|",
+// e.g. "INTERSECTION|COMPLEMENT". Supported values are ALL, COMPLEMENT,
+// EMPTY, INTERSECTION, INTERVAL, ANYSTRING and NONE. It is ignored for
+// wildcard queries.
 func (q *RegexpQuery) Flags(f string) *RegexpQuery {
 	if !q.wildcard {
 		q.params.Flags = f
@@ -250,7 +333,9 @@ func (q *RegexpQuery) Flags(f string) *RegexpQuery {
 }
 
 // MaxDeterminizedStates sets the maximum number of automaton states required
-// for the query.
+// for the query (ElasticSearch defaults to 10000). Queries needing more states
+// fail rather than consuming excessive resources, which guards against
+// pathological patterns. It is ignored for wildcard queries.
 func (q *RegexpQuery) MaxDeterminizedStates(m uint16) *RegexpQuery {
 	if !q.wildcard {
 		q.params.MaxDeterminizedStates = m
@@ -264,6 +349,35 @@ func (q *RegexpQuery) Rewrite(r string) *RegexpQuery {
 	return q
 }
 
+// CaseInsensitive sets whether the value is matched case insensitively. It is
+// only emitted when true, as it requires ElasticSearch 7.10 or newer.
+func (q *RegexpQuery) CaseInsensitive(b bool) *RegexpQuery {
+	q.params.CaseInsensitive = b
+	return q
+}
+
+// Boost sets the boost value of the query.
+func (q *RegexpQuery) Boost(b float32) *RegexpQuery {
+	q.params.Boost = b
+	return q
+}
+
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *RegexpQuery) Name(name string) *RegexpQuery {
+	q.params.Name = name
+	return q
+}
+
+// Short generates the pattern directly under the field, e.g.
+// {"regexp": {"user": "k.*y"}}, or {"wildcard": {"user": "ki*y"}} for queries
+// created via Wildcard. Setting Flags or any other option keeps the verbose
+// form.
+func (q *RegexpQuery) Short() *RegexpQuery {
+	q.short = true
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q *RegexpQuery) Map() map[string]interface{} {
@@ -275,7 +389,7 @@ func (q *RegexpQuery) Map() map[string]interface{} {
 	}
 	return map[string]interface{}{
 		qType: map[string]interface{}{
-			q.field: structs.Map(q.params),
+			q.field: leafParams(structs.Map(q.params), "value", q.short),
 		},
 	}
 }
@@ -303,16 +417,18 @@ func Wildcard(field, value string) *RegexpQuery {
 // https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-fuzzy-query.html
 type FuzzyQuery struct {
 	field  string
+	short  bool
 	params fuzzyQueryParams
 }
 
 type fuzzyQueryParams struct {
-	Value          string `structs:"value"`
-	Fuzziness      string `structs:"fuzziness,omitempty"`
-	MaxExpansions  uint16 `structs:"max_expansions,omitempty"`
-	PrefixLength   uint16 `structs:"prefix_length,omitempty"`
-	Transpositions *bool  `structs:"transpositions,omitempty"`
-	Rewrite        string `structs:"rewrite,omitempty"`
+	Value          string      `structs:"value"`
+	Fuzziness      interface{} `structs:"fuzziness,omitempty"`
+	MaxExpansions  uint16      `structs:"max_expansions,omitempty"`
+	PrefixLength   uint16      `structs:"prefix_length,omitempty"`
+	Transpositions *bool       `structs:"transpositions,omitempty"`
+	Rewrite        string      `structs:"rewrite,omitempty"`
+	Name           string      `structs:"_name,omitempty"`
 }
 
 // Fuzzy creates a new query of type "fuzzy" on the provided field and using
@@ -332,8 +448,9 @@ func (q *FuzzyQuery) Value(val string) *FuzzyQuery {
 	return q
 }
 
-// Fuzziness sets the maximum edit distance allowed for matching.
-func (q *FuzzyQuery) Fuzziness(fuzz string) *FuzzyQuery {
+// Fuzziness sets the maximum edit distance allowed for matching. It can either
+// be an integer edit distance, or a string such as "AUTO" or "AUTO:3,6".
+func (q *FuzzyQuery) Fuzziness(fuzz interface{}) *FuzzyQuery {
 	q.params.Fuzziness = fuzz
 	return q
 }
@@ -364,12 +481,27 @@ func (q *FuzzyQuery) Rewrite(s string) *FuzzyQuery {
 	return q
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *FuzzyQuery) Name(name string) *FuzzyQuery {
+	q.params.Name = name
+	return q
+}
+
+// Short generates {"fuzzy": {"user": "ki"}}, which matches with ElasticSearch's
+// default fuzziness. Setting Fuzziness or any other option keeps the verbose
+// form.
+func (q *FuzzyQuery) Short() *FuzzyQuery {
+	q.short = true
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q *FuzzyQuery) Map() map[string]interface{} {
 	return map[string]interface{}{
 		"fuzzy": map[string]interface{}{
-			q.field: structs.Map(q.params),
+			q.field: leafParams(structs.Map(q.params), "value", q.short),
 		},
 	}
 }
@@ -380,12 +512,14 @@ func (q *FuzzyQuery) Map() map[string]interface{} {
 // https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-term-query.html
 type TermQuery struct {
 	field  string
+	short  bool
 	params termQueryParams
 }
 
 type termQueryParams struct {
 	Value interface{} `structs:"value"`
 	Boost float32     `structs:"boost,omitempty"`
+	Name  string      `structs:"_name,omitempty"`
 }
 
 // Term creates a new query of type "term" on the provided field and using the
@@ -411,12 +545,26 @@ func (q *TermQuery) Boost(b float32) *TermQuery {
 	return q
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *TermQuery) Name(name string) *TermQuery {
+	q.params.Name = name
+	return q
+}
+
+// Short generates the term directly under the field, as in
+// {"term": {"user": "kimchy"}}, unless Boost or Name is set.
+func (q *TermQuery) Short() *TermQuery {
+	q.short = true
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q *TermQuery) Map() map[string]interface{} {
 	return map[string]interface{}{
 		"term": map[string]interface{}{
-			q.field: structs.Map(q.params),
+			q.field: leafParams(structs.Map(q.params), "value", q.short),
 		},
 	}
 }
@@ -426,9 +574,19 @@ func (q *TermQuery) Map() map[string]interface{} {
 // TermsQuery represents a query of type "terms", as described in:
 // https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-terms-query.html
 type TermsQuery struct {
-	field  string
-	values []interface{}
-	boost  float32
+	field   string
+	values  []interface{}
+	lookup  *termsLookup
+	routing string
+	boost   float32
+	name    string
+}
+
+type termsLookup struct {
+	Index   string `structs:"index"`
+	ID      string `structs:"id"`
+	Path    string `structs:"path"`
+	Routing string `structs:"routing,omitempty"`
 }
 
 // Terms creates a new query of type "terms" on the provided field, and
@@ -446,19 +604,58 @@ func (q *TermsQuery) Values(values ...interface{}) *TermsQuery {
 	return q
 }
 
+// Lookup sets the query to fetch its term values from the field at the
+// provided path of an existing document, identified by its index and ID, as
+// described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-terms-query.html#query-dsl-terms-lookup
+// A lookup takes precedence over term values, which are ignored when both are
+// set.
+func (q *TermsQuery) Lookup(index, id, path string) *TermsQuery {
+	q.lookup = &termsLookup{
+		Index: index,
+		ID:    id,
+		Path:  path,
+	}
+	return q
+}
+
+// Routing sets the custom routing value of the document the terms are fetched
+// from. It is only relevant if a lookup is set, see Lookup.
+func (q *TermsQuery) Routing(routing string) *TermsQuery {
+	q.routing = routing
+	return q
+}
+
 // Boost sets the boost value of the query.
 func (q *TermsQuery) Boost(b float32) *TermsQuery {
 	q.boost = b
 	return q
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *TermsQuery) Name(name string) *TermsQuery {
+	q.name = name
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q TermsQuery) Map() map[string]interface{} {
-	innerMap := map[string]interface{}{q.field: q.values}
+	var innerMap map[string]interface{}
+	if q.lookup != nil {
+		lookup := *q.lookup
+		lookup.Routing = q.routing
+		innerMap = map[string]interface{}{q.field: structs.Map(lookup)}
+	} else {
+		innerMap = map[string]interface{}{q.field: q.values}
+	}
 	if q.boost > 0 {
 		innerMap["boost"] = q.boost
 	}
+	if q.name != "" {
+		innerMap["_name"] = q.name
+	}
 
 	return map[string]interface{}{"terms": innerMap}
 }
@@ -476,6 +673,7 @@ type termsSetQueryParams struct {
 	Terms                    []string `structs:"terms"`
 	MinimumShouldMatchField  string   `structs:"minimum_should_match_field,omitempty"`
 	MinimumShouldMatchScript string   `structs:"minimum_should_match_script,omitempty"`
+	Name                     string   `structs:"_name,omitempty"`
 }
 
 // TermsSet creates a new query of type "terms_set" on the provided field and
@@ -509,6 +707,13 @@ func (q *TermsSetQuery) MinimumShouldMatchScript(script string) *TermsSetQuery {
 	return q
 }
 
+// Name sets the name of the query, which is returned in the "matched_queries"
+// of every matching hit.
+func (q *TermsSetQuery) Name(name string) *TermsSetQuery {
+	q.params.Name = name
+	return q
+}
+
 // Map returns a map representation of the query, thus implementing the
 // Mappable interface.
 func (q TermsSetQuery) Map() map[string]interface{} {
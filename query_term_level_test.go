@@ -73,6 +73,37 @@ func TestTermLevel(t *testing.T) {
 				},
 			},
 		},
+		{
+			"one-sided date math range",
+			Range("timestamp").Gte("now-7d"),
+			map[string]interface{}{
+				"range": map[string]interface{}{
+					"timestamp": map[string]interface{}{
+						"gte": "now-7d",
+					},
+				},
+			},
+		},
+		{
+			"date range with format and time zone",
+			Range("born").
+				Gt("01/01/2012").
+				Lte("2013||/y").
+				Format("dd/MM/yyyy||yyyy").
+				TimeZone("+01:00").
+				Relation(RangeWithin),
+			map[string]interface{}{
+				"range": map[string]interface{}{
+					"born": map[string]interface{}{
+						"gt":        "01/01/2012",
+						"lte":       "2013||/y",
+						"format":    "dd/MM/yyyy||yyyy",
+						"time_zone": "+01:00",
+						"relation":  "WITHIN",
+					},
+				},
+			},
+		},
 		{
 			"regexp",
 			Regexp("user", "k.*y").Flags("ALL").MaxDeterminizedStates(10000).Rewrite("constant_score"),
@@ -87,6 +118,34 @@ func TestTermLevel(t *testing.T) {
 				},
 			},
 		},
+		{
+			"regexp with operator flags and case insensitivity",
+			Regexp("id", "ab.*&~(abc.*)").
+				Flags("INTERSECTION|COMPLEMENT").
+				MaxDeterminizedStates(2000).
+				CaseInsensitive(true),
+			map[string]interface{}{
+				"regexp": map[string]interface{}{
+					"id": map[string]interface{}{
+						"value":                   "ab.*&~(abc.*)",
+						"flags":                   "INTERSECTION|COMPLEMENT",
+						"max_determinized_states": 2000,
+						"case_insensitive":        true,
+					},
+				},
+			},
+		},
+		{
+			"wildcard ignores regexp-only options",
+			Wildcard("id", "ab*").Flags("ALL").MaxDeterminizedStates(10),
+			map[string]interface{}{
+				"wildcard": map[string]interface{}{
+					"id": map[string]interface{}{
+						"value": "ab*",
+					},
+				},
+			},
+		},
 		{
 			"wildcard",
 			Wildcard("user", "ki*y").Rewrite("constant_score"),
@@ -99,6 +158,54 @@ func TestTermLevel(t *testing.T) {
 				},
 			},
 		},
+		{
+			"wildcard with case insensitivity and boost",
+			Wildcard("sku", "ab-*").CaseInsensitive(true).Boost(2),
+			map[string]interface{}{
+				"wildcard": map[string]interface{}{
+					"sku": map[string]interface{}{
+						"value":            "ab-*",
+						"case_insensitive": true,
+						"boost":            2,
+					},
+				},
+			},
+		},
+		{
+			"wildcard omits case_insensitive when false",
+			Wildcard("sku", "ab-*").CaseInsensitive(false),
+			map[string]interface{}{
+				"wildcard": map[string]interface{}{
+					"sku": map[string]interface{}{
+						"value": "ab-*",
+					},
+				},
+			},
+		},
+		{
+			"fuzzy with integer fuzziness",
+			Fuzzy("user", "ki").Fuzziness(2).PrefixLength(1),
+			map[string]interface{}{
+				"fuzzy": map[string]interface{}{
+					"user": map[string]interface{}{
+						"value":         "ki",
+						"fuzziness":     2,
+						"prefix_length": 1,
+					},
+				},
+			},
+		},
+		{
+			"fuzzy without options",
+			Fuzzy("user", "ki"),
+			map[string]interface{}{
+				"fuzzy": map[string]interface{}{
+					"user": map[string]interface{}{
+						"value": "ki",
+					},
+				},
+			},
+		},
 		{
 			"fuzzy",
 			Fuzzy("user", "ki").Fuzziness("AUTO").MaxExpansions(50).Transpositions(true),
@@ -135,6 +242,34 @@ func TestTermLevel(t *testing.T) {
 				},
 			},
 		},
+		{
+			"terms lookup",
+			Terms("user").Routing("eu").Lookup("users", "2", "followers"),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"user": map[string]interface{}{
+						"index":   "users",
+						"id":      "2",
+						"path":    "followers",
+						"routing": "eu",
+					},
+				},
+			},
+		},
+		{
+			"terms lookup takes precedence over values",
+			Terms("user", "bla").Lookup("users", "2", "followers").Boost(1.3),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"user": map[string]interface{}{
+						"index": "users",
+						"id":    "2",
+						"path":  "followers",
+					},
+					"boost": 1.3,
+				},
+			},
+		},
 		{
 			"terms_set",
 			TermsSet("programming_languages", "go", "rust", "COBOL").MinimumShouldMatchField("required_matches"),
@@ -147,5 +282,160 @@ func TestTermLevel(t *testing.T) {
 				},
 			},
 		},
+		{
+			"prefix with case insensitivity and boost",
+			Prefix("name.keyword", "Ki").CaseInsensitive(true).Boost(1.5),
+			map[string]interface{}{
+				"prefix": map[string]interface{}{
+					"name.keyword": map[string]interface{}{
+						"value":            "Ki",
+						"case_insensitive": true,
+						"boost":            1.5,
+					},
+				},
+			},
+		},
+		{
+			"ids with appended values",
+			IDs("1").Add("2", "3"),
+			map[string]interface{}{
+				"ids": map[string]interface{}{
+					"values": []string{"1", "2", "3"},
+				},
+			},
+		},
+		{
+			"exists in must_not and filter clauses",
+			Bool().
+				MustNot(Exists("deleted_at")).
+				Filter(Exists("published_at").Name("published")),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"must_not": []map[string]interface{}{
+						{
+							"exists": map[string]interface{}{
+								"field": "deleted_at",
+							},
+						},
+					},
+					"filter": []map[string]interface{}{
+						{
+							"exists": map[string]interface{}{
+								"field": "published_at",
+								"_name": "published",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"named term query",
+			Term("tag", "go").Name("tag_filter"),
+			map[string]interface{}{
+				"term": map[string]interface{}{
+					"tag": map[string]interface{}{
+						"value": "go",
+						"_name": "tag_filter",
+					},
+				},
+			},
+		},
+		{
+			"named terms query",
+			Terms("tag", "go", "rust").Name("tags_filter"),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"tag":   []string{"go", "rust"},
+					"_name": "tags_filter",
+				},
+			},
+		},
+		{
+			"named range, exists and ids queries",
+			Bool().Filter(
+				Range("age").Gte(18).Name("adults"),
+				Exists("email").Name("has_email"),
+				IDs("1", "2").Name("known"),
+			),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"filter": []map[string]interface{}{
+						{
+							"range": map[string]interface{}{
+								"age": map[string]interface{}{
+									"gte":   18,
+									"_name": "adults",
+								},
+							},
+						},
+						{
+							"exists": map[string]interface{}{
+								"field": "email",
+								"_name": "has_email",
+							},
+						},
+						{
+							"ids": map[string]interface{}{
+								"values": []string{"1", "2"},
+								"_name":  "known",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"short term",
+			Term("user", "Kimchy").Short(),
+			map[string]interface{}{
+				"term": map[string]interface{}{
+					"user": "Kimchy",
+				},
+			},
+		},
+		{
+			"short term falls back to verbose form with options",
+			Term("user", "Kimchy").Boost(1.3).Short(),
+			map[string]interface{}{
+				"term": map[string]interface{}{
+					"user": map[string]interface{}{
+						"value": "Kimchy",
+						"boost": 1.3,
+					},
+				},
+			},
+		},
+		{
+			"short named prefix falls back to verbose form",
+			Prefix("user", "ki").Short().Name("users"),
+			map[string]interface{}{
+				"prefix": map[string]interface{}{
+					"user": map[string]interface{}{
+						"value": "ki",
+						"_name": "users",
+					},
+				},
+			},
+		},
+		{
+			"short prefix, wildcard, regexp and fuzzy",
+			Bool().Should(
+				Prefix("user", "ki").Short(),
+				Wildcard("user", "ki*y").Short(),
+				Regexp("user", "k.*y").Short(),
+				Fuzzy("user", "ki").Short(),
+			),
+			map[string]interface{}{
+				"bool": map[string]interface{}{
+					"should": []map[string]interface{}{
+						{"prefix": map[string]interface{}{"user": "ki"}},
+						{"wildcard": map[string]interface{}{"user": "ki*y"}},
+						{"regexp": map[string]interface{}{"user": "k.*y"}},
+						{"fuzzy": map[string]interface{}{"user": "ki"}},
+					},
+				},
+			},
+		},
 	})
 }
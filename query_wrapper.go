@@ -0,0 +1,65 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// WrapperQuery represents a query of type "wrapper", as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-wrapper-query.html
+type WrapperQuery struct {
+	query []byte
+}
+
+// Wrapper creates a new query of type "wrapper" from the provided serialized
+// query, such as `{"term": {"user": "kimchy"}}`, which is base64-encoded as
+// ElasticSearch expects. Input that is already base64-encoded JSON is used
+// as-is rather than encoded twice. This allows embedding queries built
+// elsewhere into queries built by this library.
+func Wrapper(query []byte) *WrapperQuery {
+	return &WrapperQuery{
+		query: bytes.TrimSpace(query),
+	}
+}
+
+// encoded returns the base64-encoded query, and whether the query is valid
+// JSON.
+func (q *WrapperQuery) encoded() (string, bool) {
+	if json.Valid(q.query) {
+		return base64.StdEncoding.EncodeToString(q.query), true
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(q.query))
+	if err == nil && json.Valid(decoded) {
+		return string(q.query), true
+	}
+
+	return base64.StdEncoding.EncodeToString(q.query), false
+}
+
+// Validate checks that the query is not empty and is valid JSON (or
+// base64-encoded JSON), as ElasticSearch rejects the wrapper query otherwise.
+func (q *WrapperQuery) Validate() error {
+	if len(q.query) == 0 {
+		return errors.New("wrapper query cannot be empty")
+	}
+	if _, ok := q.encoded(); !ok {
+		return errors.New("wrapper query is not valid JSON")
+	}
+
+	return nil
+}
+
+// Map returns a map representation of the query, thus implementing the
+// Mappable interface. The query is not validated; use Validate for that.
+func (q *WrapperQuery) Map() map[string]interface{} {
+	encoded, _ := q.encoded()
+
+	return map[string]interface{}{
+		"wrapper": map[string]interface{}{
+			"query": encoded,
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestWrapper(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"wrapper with a JSON query",
+			Wrapper([]byte(`{"term" : { "user" : "Kimchy" }}`)),
+			map[string]interface{}{
+				"wrapper": map[string]interface{}{
+					"query": "eyJ0ZXJtIiA6IHsgInVzZXIiIDogIktpbWNoeSIgfX0=",
+				},
+			},
+		},
+		{
+			"wrapper with an already encoded query",
+			Wrapper([]byte("eyJ0ZXJtIiA6IHsgInVzZXIiIDogIktpbWNoeSIgfX0=\n")),
+			map[string]interface{}{
+				"wrapper": map[string]interface{}{
+					"query": "eyJ0ZXJtIiA6IHsgInVzZXIiIDogIktpbWNoeSIgfX0=",
+				},
+			},
+		},
+	})
+}
+
+func TestWrapperValidate(t *testing.T) {
+	assert.Nil(t, Wrapper([]byte(`{"match_all": {}}`)).Validate())
+	assert.Nil(t, Wrapper([]byte("eyJtYXRjaF9hbGwiOnt9fQ==")).Validate())
+	assert.NotNil(t, Wrapper(nil).Validate())
+	assert.NotNil(t, Wrapper([]byte("  ")).Validate())
+	assert.NotNil(t, Wrapper([]byte("not json")).Validate())
+}
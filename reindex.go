@@ -0,0 +1,123 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// ReindexRequest represents a request to ElasticSearch's Reindex API, which
+// copies documents from a source index to a destination index, as described
+// in https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-reindex.html
+type ReindexRequest struct {
+	source string
+	dest   string
+	query  Mappable
+	script *Script
+}
+
+// Reindex creates a new ReindexRequest object, copying documents from the
+// provided source index to the provided destination index.
+func Reindex(sourceIndex, destIndex string) *ReindexRequest {
+	return &ReindexRequest{
+		source: sourceIndex,
+		dest:   destIndex,
+	}
+}
+
+// Query sets a query to select the source documents to copy. By default, all
+// documents are copied.
+func (req *ReindexRequest) Query(q Mappable) *ReindexRequest {
+	req.query = q
+	return req
+}
+
+// Script sets a script used to transform the documents as they are copied.
+func (req *ReindexRequest) Script(script *Script) *ReindexRequest {
+	req.script = script
+	return req
+}
+
+// Map returns a map representation of the request's body, thus implementing
+// the Mappable interface.
+func (req *ReindexRequest) Map() map[string]interface{} {
+	source := map[string]interface{}{
+		"index": req.source,
+	}
+	if req.query != nil {
+		source["query"] = req.query.Map()
+	}
+
+	m := map[string]interface{}{
+		"source": source,
+		"dest": map[string]interface{}{
+			"index": req.dest,
+		},
+	}
+	if req.script != nil {
+		m["script"] = req.script.Map()
+	}
+
+	return m
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more reindex options can be provided as well. It returns the standard
+// Response type of the official Go client.
+func (req *ReindexRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.ReindexRequest),
+) (res *esapi.Response, err error) {
+	return req.RunReindex(api.Reindex, o...)
+}
+
+// RunReindex is the same as the Run method, except that it accepts a value of
+// type esapi.Reindex (usually this is the Reindex field of an
+// elasticsearch.Client object), which allows using mock clients.
+func (req *ReindexRequest) RunReindex(
+	reindex esapi.Reindex,
+	o ...func(*esapi.ReindexRequest),
+) (res *esapi.Response, err error) {
+	var b bytes.Buffer
+	err = json.NewEncoder(&b).Encode(req.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	return reindex(&b, o...)
+}
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the decoded result. The response body is closed by Do. Unsuccessful
+// responses are returned as an *ESError.
+func (req *ReindexRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.ReindexRequest),
+) (*ByQueryResult, error) {
+	return req.DoReindex(api.Reindex, o...)
+}
+
+// DoReindex is the same as the Do method, except that it accepts a value of
+// type esapi.Reindex, just like the RunReindex method.
+func (req *ReindexRequest) DoReindex(
+	reindex esapi.Reindex,
+	o ...func(*esapi.ReindexRequest),
+) (*ByQueryResult, error) {
+	res, err := CheckResponse(req.RunReindex(reindex, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var result ByQueryResult
+	err = json.NewDecoder(res.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding reindex response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,69 @@
+package elasticsearch
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestReindex(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"reindex all documents",
+			Reindex("posts", "posts_v2"),
+			map[string]interface{}{
+				"source": map[string]interface{}{
+					"index": "posts",
+				},
+				"dest": map[string]interface{}{
+					"index": "posts_v2",
+				},
+			},
+		},
+		{
+			"reindex a subset with a script",
+			Reindex("posts", "posts_2020").
+				Query(Range("date").Gte("2020-01-01").Lt("2021-01-01")).
+				Script(ScriptSource("ctx._source.remove('draft')")),
+			map[string]interface{}{
+				"source": map[string]interface{}{
+					"index": "posts",
+					"query": map[string]interface{}{
+						"range": map[string]interface{}{
+							"date": map[string]interface{}{
+								"gte": "2020-01-01",
+								"lt":  "2021-01-01",
+							},
+						},
+					},
+				},
+				"dest": map[string]interface{}{
+					"index": "posts_2020",
+				},
+				"script": map[string]interface{}{
+					"source": "ctx._source.remove('draft')",
+				},
+			},
+		},
+	})
+}
+
+func TestReindexDo(t *testing.T) {
+	var reqBody string
+	reindex := esapi.Reindex(func(body io.Reader, o ...func(*esapi.ReindexRequest)) (*esapi.Response, error) {
+		b, _ := ioutil.ReadAll(body)
+		reqBody = string(b)
+
+		return fakeResponse(200,
+			`{"took":40,"timed_out":false,"total":10,"created":10,"failures":[]}`,
+		), nil
+	})
+
+	res, err := Reindex("posts", "posts_v2").DoReindex(reindex)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"dest":{"index":"posts_v2"},"source":{"index":"posts"}}`+"\n", reqBody)
+	assert.Equal(t, int64(10), res.Created)
+}
@@ -0,0 +1,61 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+// RescoreOption represents a query rescorer, which re-scores the top hits of a
+// search request using a secondary, usually more expensive query, as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/filter-search-results.html#rescore
+type RescoreOption struct {
+	windowSize uint16
+	query      Mappable
+	params     rescoreQueryParams
+}
+
+type rescoreQueryParams struct {
+	QueryWeight        *float32 `structs:"query_weight,omitempty"`
+	RescoreQueryWeight *float32 `structs:"rescore_query_weight,omitempty"`
+	ScoreMode          string   `structs:"score_mode,omitempty"`
+}
+
+// Rescore creates a new rescorer, which executes the provided query on the top
+// windowSize hits of each shard.
+func Rescore(windowSize uint16, query Mappable) *RescoreOption {
+	return &RescoreOption{
+		windowSize: windowSize,
+		query:      query,
+	}
+}
+
+// QueryWeight sets the weight of the original query's score (ElasticSearch
+// defaults to 1).
+func (r *RescoreOption) QueryWeight(w float32) *RescoreOption {
+	r.params.QueryWeight = &w
+	return r
+}
+
+// RescoreQueryWeight sets the weight of the rescore query's score
+// (ElasticSearch defaults to 1).
+func (r *RescoreOption) RescoreQueryWeight(w float32) *RescoreOption {
+	r.params.RescoreQueryWeight = &w
+	return r
+}
+
+// ScoreMode sets how the original and rescore query scores are combined
+// ("total", "multiply", "avg", "max" or "min").
+func (r *RescoreOption) ScoreMode(mode string) *RescoreOption {
+	r.params.ScoreMode = mode
+	return r
+}
+
+// Map returns a map representation of the rescorer, thus implementing the
+// Mappable interface.
+func (r *RescoreOption) Map() map[string]interface{} {
+	query := structs.Map(r.params)
+	query["rescore_query"] = r.query.Map()
+
+	return map[string]interface{}{
+		"window_size": r.windowSize,
+		"query":       query,
+	}
+}
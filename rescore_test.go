@@ -0,0 +1,85 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestRescore(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"single rescore phase",
+			Search().
+				Query(Match("message", "the quick brown")).
+				Rescore(
+					Rescore(100, FunctionScore(MatchPhrase("message", "the quick brown"))).
+						QueryWeight(0.7).
+						RescoreQueryWeight(1.2).
+						ScoreMode("multiply"),
+				),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match": map[string]interface{}{
+						"message": map[string]interface{}{
+							"query": "the quick brown",
+						},
+					},
+				},
+				"rescore": map[string]interface{}{
+					"window_size": 100,
+					"query": map[string]interface{}{
+						"rescore_query": map[string]interface{}{
+							"function_score": map[string]interface{}{
+								"query": map[string]interface{}{
+									"match_phrase": map[string]interface{}{
+										"message": map[string]interface{}{
+											"query": "the quick brown",
+										},
+									},
+								},
+							},
+						},
+						"query_weight":         0.7,
+						"rescore_query_weight": 1.2,
+						"score_mode":           "multiply",
+					},
+				},
+			},
+		},
+		{
+			"multiple rescore phases",
+			Search().Rescore(
+				Rescore(100, Match("title", "go")),
+				Rescore(10, Match("body", "go")).QueryWeight(0),
+			),
+			map[string]interface{}{
+				"rescore": []map[string]interface{}{
+					{
+						"window_size": 100,
+						"query": map[string]interface{}{
+							"rescore_query": map[string]interface{}{
+								"match": map[string]interface{}{
+									"title": map[string]interface{}{
+										"query": "go",
+									},
+								},
+							},
+						},
+					},
+					{
+						"window_size": 10,
+						"query": map[string]interface{}{
+							"rescore_query": map[string]interface{}{
+								"match": map[string]interface{}{
+									"body": map[string]interface{}{
+										"query": "go",
+									},
+								},
+							},
+							"query_weight": 0,
+						},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// Result represents the decoded response of a search request, where the source
+// of every hit is decoded into a value of type T.
+type Result[T any] struct {
+	// Total is the total number of matching documents. Unless total hits are
+	// tracked accurately, it may be a lower bound (see TotalRelation).
+	Total int64
+
+	// TotalRelation is "eq" if Total is accurate, or "gte" if it's a lower
+	// bound.
+	TotalRelation string
+
+	// MaxScore is the highest score of all matching documents.
+	MaxScore float64
+
+	// Hits is the list of documents returned by the request.
+	Hits []Hit[T]
+
+	// Aggregations holds the results of the aggregations of the request, if
+	// any.
+	Aggregations AggResults
+}
+
+// Hit represents a single document in the response of a search request.
+type Hit[T any] struct {
+	// Index is the name of the index containing the document.
+	Index string `json:"_index"`
+
+	// ID is the ID of the document.
+	ID string `json:"_id"`
+
+	// Score is the score of the document.
+	Score float64 `json:"_score"`
+
+	// Source is the decoded source of the document.
+	Source T `json:"_source"`
+
+	// Sort holds the sort values of the document, if the request was sorted.
+	Sort []interface{} `json:"sort,omitempty"`
+}
+
+// SearchTyped executes a search request using the provided ElasticSearch
+// client, and decodes the source of each hit into a value of type T. The
+// provided value can either be a SearchRequest, or a query, in which case a
+// SearchRequest is created for it. Zero or more search options can be provided
+// as well. The response body is closed by SearchTyped. Unsuccessful responses
+// are returned as an *ESError.
+func SearchTyped[T any](
+	q Mappable,
+	api *elasticsearch.Client,
+	o ...func(*esapi.SearchRequest),
+) (*Result[T], error) {
+	return RunSearchTyped[T](q, api.Search, o...)
+}
+
+// RunSearchTyped is the same as SearchTyped, except that it accepts a value of
+// type esapi.Search (usually this is the Search field of an
+// elasticsearch.Client object), just like the RunSearch method of
+// SearchRequest.
+func RunSearchTyped[T any](
+	q Mappable,
+	search esapi.Search,
+	o ...func(*esapi.SearchRequest),
+) (*Result[T], error) {
+	res, err := CheckResponse(asSearchRequest(q).RunSearch(search, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	return DecodeResult[T](res.Body)
+}
+
+// DecodeResult decodes the body of a successful search response, decoding the
+// source of each hit into a value of type T. It is only needed for responses
+// that do not come from the v7 client, as SearchTyped already uses it.
+func DecodeResult[T any](r io.Reader) (*Result[T], error) {
+	var body struct {
+		Hits struct {
+			Total    searchTotal `json:"total"`
+			MaxScore float64     `json:"max_score"`
+			Hits     []Hit[T]    `json:"hits"`
+		} `json:"hits"`
+		Aggregations AggResults `json:"aggregations"`
+	}
+	err := json.NewDecoder(r).Decode(&body)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding search response: %w", err)
+	}
+
+	return &Result[T]{
+		Total:         body.Hits.Total.Value,
+		TotalRelation: body.Hits.Total.Relation,
+		MaxScore:      body.Hits.MaxScore,
+		Hits:          body.Hits.Hits,
+		Aggregations:  body.Aggregations,
+	}, nil
+}
+
+// searchTotal is the "total" component of search responses. ElasticSearch
+// returns it as an object by default, or as a number when the
+// rest_total_hits_as_int parameter is provided.
+type searchTotal struct {
+	Value    int64  `json:"value"`
+	Relation string `json:"relation"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (total *searchTotal) UnmarshalJSON(b []byte) error {
+	var n int64
+	if err := json.Unmarshal(b, &n); err == nil {
+		total.Value = n
+		total.Relation = "eq"
+		return nil
+	}
+
+	type plain searchTotal
+	return json.Unmarshal(b, (*plain)(total))
+}
@@ -0,0 +1,89 @@
+package elasticsearch
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+type testDoc struct {
+	Title string `json:"title"`
+	Likes int    `json:"likes"`
+}
+
+// fakeResponse returns a response with the provided status code and body, as
+// returned by the fake esapi functions of the tests.
+func fakeResponse(status int, body string) *esapi.Response {
+	return &esapi.Response{
+		StatusCode: status,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func fakeSearch(status int, resBody string, reqBody *string) esapi.Search {
+	return func(o ...func(*esapi.SearchRequest)) (*esapi.Response, error) {
+		var req esapi.SearchRequest
+		for _, f := range o {
+			f(&req)
+		}
+		if reqBody != nil && req.Body != nil {
+			b, _ := ioutil.ReadAll(req.Body)
+			*reqBody = string(b)
+		}
+
+		return fakeResponse(status, resBody), nil
+	}
+}
+
+func TestSearchTyped(t *testing.T) {
+	var reqBody string
+	search := fakeSearch(200, `{
+		"took": 3,
+		"hits": {
+			"total": {"value": 2, "relation": "eq"},
+			"max_score": 1.5,
+			"hits": [
+				{"_index": "posts", "_id": "1", "_score": 1.5, "_source": {"title": "Go", "likes": 3}},
+				{"_index": "posts", "_id": "2", "_score": 0.5, "_source": {"title": "Rust", "likes": 1}}
+			]
+		}
+	}`, &reqBody)
+
+	res, err := RunSearchTyped[testDoc](Term("tag", "tech"), search)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"query":{"term":{"tag":{"value":"tech"}}}}`+"\n", reqBody)
+	assert.Equal(t, int64(2), res.Total)
+	assert.Equal(t, "eq", res.TotalRelation)
+	assert.Equal(t, 1.5, res.MaxScore)
+	assert.Equal(t, 2, len(res.Hits))
+	assert.Equal(t, "1", res.Hits[0].ID)
+	assert.Equal(t, "posts", res.Hits[0].Index)
+	assert.Equal(t, testDoc{Title: "Go", Likes: 3}, res.Hits[0].Source)
+	assert.Equal(t, 0.5, res.Hits[1].Score)
+
+	_, err = RunSearchTyped[testDoc](Search().Query(MatchAll()).Size(1), search)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"query":{"match_all":{}},"size":1}`+"\n", reqBody)
+}
+
+func TestSearchTypedIntTotal(t *testing.T) {
+	search := fakeSearch(200, `{"hits": {"total": 7, "max_score": null, "hits": []}}`, nil)
+
+	res, err := RunSearchTyped[testDoc](MatchAll(), search)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), res.Total)
+	assert.Equal(t, 0.0, res.MaxScore)
+	assert.Equal(t, 0, len(res.Hits))
+}
+
+func TestSearchTypedError(t *testing.T) {
+	search := fakeSearch(400, `{"error": {"type": "parsing_exception", "reason": "bad"}, "status": 400}`, nil)
+
+	_, err := RunSearchTyped[testDoc](MatchAll(), search)
+	esErr, ok := err.(*ESError)
+	assert.True(t, ok)
+	assert.Equal(t, "parsing_exception", esErr.Type)
+}
@@ -0,0 +1,54 @@
+package elasticsearch
+
+// Script represents a script, as used in script queries, script_score
+// functions, script fields, sorts and aggregations. It is described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/modules-scripting-using.html
+type Script struct {
+	source string
+	id     string
+	lang   string
+	params map[string]interface{}
+}
+
+// ScriptSource creates a new inline script with the provided source.
+func ScriptSource(source string) *Script {
+	return &Script{source: source}
+}
+
+// ScriptID creates a new script referencing the stored script with the
+// provided ID.
+func ScriptID(id string) *Script {
+	return &Script{id: id}
+}
+
+// Lang sets the language of the script. ElasticSearch defaults to "painless".
+func (s *Script) Lang(lang string) *Script {
+	s.lang = lang
+	return s
+}
+
+// Params sets the parameters passed to the script.
+func (s *Script) Params(params map[string]interface{}) *Script {
+	s.params = params
+	return s
+}
+
+// Map returns a map representation of the script, thus implementing the
+// Mappable interface. Stored scripts are referenced by "id", inline scripts
+// include their "source".
+func (s *Script) Map() map[string]interface{} {
+	m := make(map[string]interface{})
+	if s.id != "" {
+		m["id"] = s.id
+	} else {
+		m["source"] = s.source
+	}
+	if s.lang != "" {
+		m["lang"] = s.lang
+	}
+	if len(s.params) > 0 {
+		m["params"] = s.params
+	}
+
+	return m
+}
@@ -0,0 +1,169 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// DefaultScrollKeepAlive is the default duration for which ElasticSearch keeps
+// the search context of a scroll request alive between batches.
+const DefaultScrollKeepAlive = time.Minute
+
+// ScrollRequest represents a request to iterate over all documents matching a
+// query using ElasticSearch's scroll API, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/paginate-search-results.html#scroll-search-results
+type ScrollRequest struct {
+	query     Mappable
+	keepAlive time.Duration
+	size      *uint64
+}
+
+// Scroll creates a new scroll request with the provided query.
+func Scroll(q Mappable) *ScrollRequest {
+	return &ScrollRequest{
+		query:     q,
+		keepAlive: DefaultScrollKeepAlive,
+	}
+}
+
+// KeepAlive sets how long ElasticSearch should keep the search context alive
+// between batches. It defaults to DefaultScrollKeepAlive.
+func (req *ScrollRequest) KeepAlive(dur time.Duration) *ScrollRequest {
+	req.keepAlive = dur
+	return req
+}
+
+// Size sets the number of hits to return in each batch.
+func (req *ScrollRequest) Size(size uint64) *ScrollRequest {
+	req.size = &size
+	return req
+}
+
+// Map returns a map representation of the request's body, thus implementing
+// the Mappable interface.
+func (req *ScrollRequest) Map() map[string]interface{} {
+	m := map[string]interface{}{
+		"query": req.query.Map(),
+	}
+	if req.size != nil {
+		m["size"] = *req.size
+	}
+
+	return m
+}
+
+// Each executes the request using the provided ElasticSearch client, calling
+// fn with the response of every batch of hits, until a batch with no hits is
+// returned. Zero or more search options can be provided for the initial search
+// request. Response bodies are read and closed by Each, fn receives responses
+// with an in-memory copy of the body. The scroll context is cleared once
+// iteration ends, even when it ends with an error. If fn returns an error,
+// iteration stops and the error is returned. Unsuccessful responses are
+// returned as an *ESError.
+func (req *ScrollRequest) Each(
+	api *elasticsearch.Client,
+	fn func(batch *esapi.Response) error,
+	o ...func(*esapi.SearchRequest),
+) error {
+	return req.EachScroll(api.Search, api.Scroll, api.ClearScroll, fn, o...)
+}
+
+// EachScroll is the same as the Each method, except that it accepts values of
+// type esapi.Search, esapi.Scroll and esapi.ClearScroll (usually these are the
+// Search, Scroll and ClearScroll fields of an elasticsearch.Client object),
+// just like the RunSearch method of SearchRequest.
+func (req *ScrollRequest) EachScroll(
+	search esapi.Search,
+	scroll esapi.Scroll,
+	clear esapi.ClearScroll,
+	fn func(batch *esapi.Response) error,
+	o ...func(*esapi.SearchRequest),
+) (err error) {
+	var b bytes.Buffer
+	err = json.NewEncoder(&b).Encode(req.Map())
+	if err != nil {
+		return err
+	}
+
+	opts := append([]func(*esapi.SearchRequest){
+		search.WithBody(&b),
+		search.WithScroll(req.keepAlive),
+	}, o...)
+
+	var scrollID string
+	defer func() {
+		if scrollID == "" {
+			return
+		}
+
+		res, clearErr := CheckResponse(clear(clear.WithScrollID(scrollID)))
+		if res != nil {
+			res.Body.Close()
+		}
+		if err == nil {
+			err = clearErr
+		}
+	}()
+
+	res, err := CheckResponse(search(opts...))
+	for err == nil {
+		var numHits int
+		scrollID, numHits, err = readScrollBatch(res, scrollID)
+		if err != nil || numHits == 0 {
+			return err
+		}
+
+		err = fn(res)
+		if err != nil {
+			return err
+		}
+
+		res, err = CheckResponse(scroll(
+			scroll.WithScrollID(scrollID),
+			scroll.WithScroll(req.keepAlive),
+		))
+	}
+
+	return err
+}
+
+// readScrollBatch reads the scroll ID and number of hits from the body of a
+// scroll response. The body is closed and replaced with an in-memory copy, so
+// that it can still be read by the caller. The previous scroll ID is returned
+// if the response doesn't include one.
+func readScrollBatch(res *esapi.Response, prevID string) (
+	scrollID string,
+	numHits int,
+	err error,
+) {
+	b, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return prevID, 0, err
+	}
+
+	res.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	var body struct {
+		ScrollID string `json:"_scroll_id"`
+		Hits     struct {
+			Hits []json.RawMessage `json:"hits"`
+		} `json:"hits"`
+	}
+	err = json.Unmarshal(b, &body)
+	if err != nil {
+		return prevID, 0, fmt.Errorf("failed decoding scroll response: %w", err)
+	}
+
+	if body.ScrollID == "" {
+		body.ScrollID = prevID
+	}
+
+	return body.ScrollID, len(body.Hits.Hits), nil
+}
@@ -0,0 +1,154 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestScrollMap(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"scroll request with a size",
+			Scroll(Term("user", "kimchy")).Size(500).KeepAlive(5 * time.Minute),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"term": map[string]interface{}{
+						"user": map[string]interface{}{
+							"value": "kimchy",
+						},
+					},
+				},
+				"size": 500,
+			},
+		},
+	})
+}
+
+type fakeScroller struct {
+	batches   []int
+	scrolls   int
+	clearedID string
+	clearBody *closeTracker
+}
+
+// closeTracker is a response body recording whether it was closed.
+type closeTracker struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (f *fakeScroller) response(scrollID string, numHits int) *esapi.Response {
+	hits := make([]string, numHits)
+	for i := range hits {
+		hits[i] = `{"_id":"x"}`
+	}
+
+	return fakeResponse(200, fmt.Sprintf(
+		`{"_scroll_id":%q,"hits":{"hits":[%s]}}`,
+		scrollID,
+		strings.Join(hits, ","),
+	))
+}
+
+func (f *fakeScroller) search(o ...func(*esapi.SearchRequest)) (*esapi.Response, error) {
+	return f.response("scroll-1", f.batches[0]), nil
+}
+
+func (f *fakeScroller) scroll(o ...func(*esapi.ScrollRequest)) (*esapi.Response, error) {
+	var req esapi.ScrollRequest
+	for _, opt := range o {
+		opt(&req)
+	}
+	if req.ScrollID != "scroll-1" {
+		return nil, fmt.Errorf("unexpected scroll ID %q", req.ScrollID)
+	}
+
+	f.scrolls++
+	return f.response("", f.batches[f.scrolls]), nil
+}
+
+func (f *fakeScroller) clear(o ...func(*esapi.ClearScrollRequest)) (*esapi.Response, error) {
+	var req esapi.ClearScrollRequest
+	for _, opt := range o {
+		opt(&req)
+	}
+	f.clearedID = strings.Join(req.ScrollID, ",")
+	f.clearBody = &closeTracker{Reader: strings.NewReader(`{"succeeded":true}`)}
+
+	return &esapi.Response{
+		StatusCode: 200,
+		Body:       f.clearBody,
+	}, nil
+}
+
+func TestScrollEach(t *testing.T) {
+	f := &fakeScroller{batches: []int{2, 2, 1, 0}}
+
+	var total int
+	err := Scroll(MatchAll()).Size(2).EachScroll(
+		f.search, f.scroll, f.clear,
+		func(batch *esapi.Response) error {
+			var body struct {
+				Hits struct {
+					Hits []interface{} `json:"hits"`
+				} `json:"hits"`
+			}
+			b, _ := ioutil.ReadAll(batch.Body)
+			if err := json.Unmarshal(b, &body); err != nil {
+				return err
+			}
+			total += len(body.Hits.Hits)
+			return nil
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 5, total)
+	assert.Equal(t, 3, f.scrolls)
+	assert.Equal(t, "scroll-1", f.clearedID)
+}
+
+func TestScrollEachError(t *testing.T) {
+	f := &fakeScroller{batches: []int{2, 2, 0}}
+	fnErr := errors.New("failed processing batch")
+
+	err := Scroll(MatchAll()).EachScroll(
+		f.search, f.scroll, f.clear,
+		func(batch *esapi.Response) error {
+			return fnErr
+		},
+	)
+
+	assert.Equal(t, fnErr, err)
+	assert.Equal(t, 0, f.scrolls)
+	assert.Equal(t, "scroll-1", f.clearedID)
+}
+
+func TestScrollEachClosesClearResponse(t *testing.T) {
+	f := &fakeScroller{batches: []int{1, 0}}
+
+	err := Scroll(MatchAll()).EachScroll(
+		f.search, f.scroll, f.clear,
+		func(batch *esapi.Response) error {
+			return nil
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "scroll-1", f.clearedID)
+	assert.True(t, f.clearBody != nil && f.clearBody.closed)
+}
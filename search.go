@@ -15,17 +15,27 @@ import (
 // Not all features of the search API are currently supported, but a request can
 // currently include a query, aggregations, and more.
 type SearchRequest struct {
-	aggs        []Aggregation
-	explain     *bool
-	from        *uint64
-	highlight   Mappable
-	searchAfter []interface{}
-	postFilter  Mappable
-	query       Mappable
-	size        *uint64
-	sort        Sort
-	source      Source
-	timeout     *time.Duration
+	aggs           []Aggregation
+	collapse       *CollapseOption
+	docValueFields []interface{}
+	explain        *bool
+	from           *uint64
+	highlight      Mappable
+	indicesBoost   []map[string]interface{}
+	minScore       *float32
+	searchAfter    []interface{}
+	postFilter     Mappable
+	query          Mappable
+	rescore        []*RescoreOption
+	runtimeFields  map[string]interface{}
+	scriptFields   map[string]*Script
+	size           *uint64
+	sort           Sort
+	source         Source
+	storedFields   []string
+	suggest        []Suggester
+	timeout        *time.Duration
+	trackTotalHits interface{}
 }
 
 // Search creates a new SearchRequest object, to be filled via method chaining.
@@ -45,20 +55,29 @@ func (req *SearchRequest) Aggs(aggs ...Aggregation) *SearchRequest {
 	return req
 }
 
-// PostFilter sets a post_filter for the request.
+// PostFilter sets a post_filter for the request. The post filter is applied to
+// the search hits after aggregations have been computed, so aggregations see
+// all documents matching the query while hits are narrowed down. This is the
+// usual way to compute facet counts, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/filter-search-results.html#post-filter
 func (req *SearchRequest) PostFilter(filter Mappable) *SearchRequest {
 	req.postFilter = filter
 	return req
 }
 
-// From sets a document offset to start from.
+// From sets a document offset to start from. Note that a "from" URL parameter
+// (e.g. one provided via es.Search.WithFrom) takes precedence over the value in
+// the request body.
 func (req *SearchRequest) From(offset uint64) *SearchRequest {
 	req.from = &offset
 	return req
 }
 
 // Size sets the number of hits to return. The default - according to the ES
-// documentation - is 10.
+// documentation - is 10. A size of zero is sent to ElasticSearch as well, which
+// is useful for requests that are only interested in aggregations. Note that a
+// "size" URL parameter (e.g. one provided via es.Search.WithSize) takes
+// precedence over the value in the request body.
 func (req *SearchRequest) Size(size uint64) *SearchRequest {
 	req.size = &size
 	return req
@@ -75,7 +94,23 @@ func (req *SearchRequest) Sort(name string, order Order) *SearchRequest {
 	return req
 }
 
-// SearchAfter retrieve the sorted result
+// SortBy adds one or more sort criteria to the request, such as those created
+// by the SortBy function. SortBy can be called multiple times, and can be
+// combined with Sort, criteria will be appended to existing ones.
+func (req *SearchRequest) SortBy(sorts ...Mappable) *SearchRequest {
+	for _, s := range sorts {
+		req.sort = append(req.sort, s.Map())
+	}
+
+	return req
+}
+
+// SearchAfter sets the sort values of the last hit of the previous page, so
+// that the request retrieves the next page of hits, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/paginate-search-results.html#search-after
+// The values are sent as-is (including nil values), and the request must be
+// sorted deterministically, usually by including a tiebreaker field with
+// unique values.
 func (req *SearchRequest) SearchAfter(s ...interface{}) *SearchRequest {
 	req.searchAfter = append(req.searchAfter, s...)
 	return req
@@ -106,6 +141,119 @@ func (req *SearchRequest) SourceExcludes(keys ...string) *SearchRequest {
 	return req
 }
 
+// SourceFalse disables returning the source of matching documents altogether.
+// It takes precedence over SourceIncludes and SourceExcludes.
+func (req *SearchRequest) SourceFalse() *SearchRequest {
+	req.source.disabled = true
+	return req
+}
+
+// DocValueFields adds one or more fields to return from the doc values of the
+// matching documents, in the "fields" section of every hit. It can be called
+// multiple times, and combined with DocValueField.
+func (req *SearchRequest) DocValueFields(fields ...string) *SearchRequest {
+	for _, field := range fields {
+		req.docValueFields = append(req.docValueFields, field)
+	}
+	return req
+}
+
+// DocValueField adds a field to return from the doc values of the matching
+// documents, using the provided format (e.g. "epoch_millis" for date fields).
+func (req *SearchRequest) DocValueField(field, format string) *SearchRequest {
+	req.docValueFields = append(req.docValueFields, map[string]interface{}{
+		"field":  field,
+		"format": format,
+	})
+	return req
+}
+
+// StoredFields sets the stored fields to return for the matching documents.
+// Only fields explicitly marked as stored in the mapping can be returned.
+func (req *SearchRequest) StoredFields(fields ...string) *SearchRequest {
+	req.storedFields = append(req.storedFields, fields...)
+	return req
+}
+
+// ScriptField adds a field computed by the provided script to every hit, under
+// the provided name. It can be called multiple times to add several fields; a
+// field added under an existing name replaces it.
+func (req *SearchRequest) ScriptField(name string, script *Script) *SearchRequest {
+	if req.scriptFields == nil {
+		req.scriptFields = make(map[string]*Script)
+	}
+	req.scriptFields[name] = script
+	return req
+}
+
+// RuntimeMapping defines a runtime field with the provided name and type (e.g.
+// "keyword", "long" or "date"), computed at query time by the provided script.
+// The field can then be used in queries, aggregations and sorts like any other
+// field. The script may be nil, in which case the field is read from the
+// source of the documents. A field defined under an existing name replaces it.
+func (req *SearchRequest) RuntimeMapping(name, fieldType string, script *Script) *SearchRequest {
+	if req.runtimeFields == nil {
+		req.runtimeFields = make(map[string]interface{})
+	}
+
+	field := map[string]interface{}{
+		"type": fieldType,
+	}
+	if script != nil {
+		field["script"] = script.Map()
+	}
+	req.runtimeFields[name] = field
+
+	return req
+}
+
+// MinScore sets the minimum score of hits to return; hits scoring lower are
+// excluded. It is only included in the request if set, zero included.
+func (req *SearchRequest) MinScore(score float32) *SearchRequest {
+	req.minScore = &score
+	return req
+}
+
+// TrackTotalHits sets how the total number of hits is tracked. It accepts true
+// to always count hits accurately, false to disable counting, or an integer to
+// count accurately up to that number. ElasticSearch counts up to 10,000 hits by
+// default.
+func (req *SearchRequest) TrackTotalHits(v interface{}) *SearchRequest {
+	req.trackTotalHits = v
+	return req
+}
+
+// Collapse sets a collapse option for the request, see Collapse.
+func (req *SearchRequest) Collapse(c *CollapseOption) *SearchRequest {
+	req.collapse = c
+	return req
+}
+
+// Suggest adds one or more suggesters to the request. Suggest can be called
+// multiple times, suggesters will be appended to existing ones.
+func (req *SearchRequest) Suggest(suggesters ...Suggester) *SearchRequest {
+	req.suggest = append(req.suggest, suggesters...)
+	return req
+}
+
+// Rescore adds one or more rescore phases to the request, see Rescore. Phases
+// are executed in the order they are added.
+func (req *SearchRequest) Rescore(r ...*RescoreOption) *SearchRequest {
+	req.rescore = append(req.rescore, r...)
+	return req
+}
+
+// IndicesBoost boosts the scores of hits from the provided index (or alias, or
+// wildcard expression) by the provided factor. It can be called multiple times,
+// and boosts are sent in the order they were added, which matters when an index
+// matches several of them: the first match is used.
+func (req *SearchRequest) IndicesBoost(index string, boost float32) *SearchRequest {
+	req.indicesBoost = append(req.indicesBoost, map[string]interface{}{
+		index: boost,
+	})
+	return req
+}
+
 // Highlight sets a highlight for the request.
 func (req *SearchRequest) Highlight(highlight Mappable) *SearchRequest {
 	req.highlight = highlight
@@ -120,12 +268,7 @@ func (req *SearchRequest) Map() map[string]interface{} {
 		m["query"] = req.query.Map()
 	}
 	if len(req.aggs) > 0 {
-		aggs := make(map[string]interface{})
-		for _, agg := range req.aggs {
-			aggs[agg.Name()] = agg.Map()
-		}
-
-		m["aggs"] = aggs
+		m["aggs"] = aggsMap(req.aggs)
 	}
 	if req.postFilter != nil {
 		m["post_filter"] = req.postFilter.Map()
@@ -151,9 +294,56 @@ func (req *SearchRequest) Map() map[string]interface{} {
 	if req.searchAfter != nil {
 		m["search_after"] = req.searchAfter
 	}
+	if len(req.rescore) == 1 {
+		m["rescore"] = req.rescore[0].Map()
+	} else if len(req.rescore) > 1 {
+		rescore := make([]map[string]interface{}, len(req.rescore))
+		for i, r := range req.rescore {
+			rescore[i] = r.Map()
+		}
+		m["rescore"] = rescore
+	}
+	if len(req.suggest) > 0 {
+		suggest := make(map[string]interface{}, len(req.suggest))
+		for _, s := range req.suggest {
+			suggest[s.Name()] = s.Map()
+		}
+		m["suggest"] = suggest
+	}
+	if req.collapse != nil {
+		m["collapse"] = req.collapse.Map()
+	}
+	if req.minScore != nil {
+		m["min_score"] = *req.minScore
+	}
+	if req.trackTotalHits != nil {
+		m["track_total_hits"] = req.trackTotalHits
+	}
+	if len(req.runtimeFields) > 0 {
+		m["runtime_mappings"] = req.runtimeFields
+	}
+	if len(req.indicesBoost) > 0 {
+		m["indices_boost"] = req.indicesBoost
+	}
+	if len(req.docValueFields) > 0 {
+		m["docvalue_fields"] = req.docValueFields
+	}
+	if len(req.storedFields) > 0 {
+		m["stored_fields"] = req.storedFields
+	}
+	if len(req.scriptFields) > 0 {
+		fields := make(map[string]interface{}, len(req.scriptFields))
+		for name, script := range req.scriptFields {
+			fields[name] = map[string]interface{}{
+				"script": script.Map(),
+			}
+		}
+		m["script_fields"] = fields
+	}
 
-	source := req.source.Map()
-	if len(source) > 0 {
+	if req.source.disabled {
+		m["_source"] = false
+	} else if source := req.source.Map(); len(source) > 0 {
 		m["_source"] = source
 	}
 
@@ -197,6 +387,16 @@ func (req *SearchRequest) RunSearch(
 	return search(opts...)
 }
 
+// asSearchRequest returns the provided value if it is a SearchRequest, or a new
+// SearchRequest with the provided value as its query otherwise.
+func asSearchRequest(q Mappable) *SearchRequest {
+	if req, ok := q.(*SearchRequest); ok {
+		return req
+	}
+
+	return Query(q)
+}
+
 // Query is a shortcut for creating a SearchRequest with only a query. It is
 // mostly included to maintain the API provided by elasticsearch in early releases.
 func Query(q Mappable) *SearchRequest {
@@ -14,6 +14,34 @@ func TestSearchMaps(t *testing.T) {
 				"search_after": []string{"_id", "name"},
 			},
 		},
+		{
+			"search after with mixed and nil sort values",
+			Search().
+				Query(MatchAll()).
+				Sort("date", OrderDesc).
+				Sort("user_id", OrderAsc).
+				SearchAfter(1463538857, nil, "kimchy"),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match_all": map[string]interface{}{},
+				},
+				"sort": []map[string]interface{}{
+					{"date": map[string]interface{}{"order": "desc"}},
+					{"user_id": map[string]interface{}{"order": "asc"}},
+				},
+				"search_after": []interface{}{1463538857, nil, "kimchy"},
+			},
+		},
+		{
+			"a query with source retrieval disabled",
+			Search().Query(MatchAll()).SourceIncludes("field_1").SourceFalse(),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match_all": map[string]interface{}{},
+				},
+				"_source": false,
+			},
+		},
 		{
 			"a simple match_all query with a size and no aggs",
 			Search().Query(MatchAll()).Size(20),
@@ -24,6 +52,21 @@ func TestSearchMaps(t *testing.T) {
 				"size": 20,
 			},
 		},
+		{
+			"an aggregation-only request with a zero size",
+			Search().Aggs(Avg("average_score", "score")).Size(0).From(0),
+			map[string]interface{}{
+				"aggs": map[string]interface{}{
+					"average_score": map[string]interface{}{
+						"avg": map[string]interface{}{
+							"field": "score",
+						},
+					},
+				},
+				"size": 0,
+				"from": 0,
+			},
+		},
 		{
 			"a complex query with an aggregation and various other options",
 			Search().
@@ -120,5 +163,183 @@ func TestSearchMaps(t *testing.T) {
 				},
 			},
 		},
+		{
+			"a search request with script fields",
+			Search().
+				Query(MatchAll()).
+				ScriptField("discounted",
+					ScriptSource("doc['price'].value * params.factor").
+						Params(map[string]interface{}{"factor": 0.9})).
+				ScriptField("doubled", ScriptSource("doc['price'].value * 2")),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match_all": map[string]interface{}{},
+				},
+				"script_fields": map[string]interface{}{
+					"discounted": map[string]interface{}{
+						"script": map[string]interface{}{
+							"source": "doc['price'].value * params.factor",
+							"params": map[string]interface{}{
+								"factor": 0.9,
+							},
+						},
+					},
+					"doubled": map[string]interface{}{
+						"script": map[string]interface{}{
+							"source": "doc['price'].value * 2",
+						},
+					},
+				},
+			},
+		},
+		{
+			"a search request with doc value and stored fields",
+			Search().
+				Query(MatchAll()).
+				DocValueFields("user", "tags").
+				DocValueField("created_at", "epoch_millis").
+				StoredFields("title", "body").
+				SourceFalse(),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match_all": map[string]interface{}{},
+				},
+				"docvalue_fields": []interface{}{
+					"user",
+					"tags",
+					map[string]interface{}{
+						"field":  "created_at",
+						"format": "epoch_millis",
+					},
+				},
+				"stored_fields": []string{"title", "body"},
+				"_source":       false,
+			},
+		},
+		{
+			"a search request with ordered indices boosts",
+			Search().
+				Query(Match("title", "shoes")).
+				IndicesBoost("premium", 1.4).
+				IndicesBoost("general*", 1),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match": map[string]interface{}{
+						"title": map[string]interface{}{
+							"query": "shoes",
+						},
+					},
+				},
+				"indices_boost": []map[string]interface{}{
+					{"premium": 1.4},
+					{"general*": 1},
+				},
+			},
+		},
+		{
+			"a search request with runtime fields",
+			Search().
+				RuntimeMapping("day_of_week", "keyword", ScriptSource(
+					"emit(doc['timestamp'].value.dayOfWeekEnum.toString())",
+				)).
+				RuntimeMapping("raw_price", "double", nil).
+				Query(Term("day_of_week", "MONDAY")).
+				Aggs(TermsAgg("days", "day_of_week")),
+			map[string]interface{}{
+				"runtime_mappings": map[string]interface{}{
+					"day_of_week": map[string]interface{}{
+						"type": "keyword",
+						"script": map[string]interface{}{
+							"source": "emit(doc['timestamp'].value.dayOfWeekEnum.toString())",
+						},
+					},
+					"raw_price": map[string]interface{}{
+						"type": "double",
+					},
+				},
+				"query": map[string]interface{}{
+					"term": map[string]interface{}{
+						"day_of_week": map[string]interface{}{
+							"value": "MONDAY",
+						},
+					},
+				},
+				"aggs": map[string]interface{}{
+					"days": map[string]interface{}{
+						"terms": map[string]interface{}{
+							"field": "day_of_week",
+						},
+					},
+				},
+			},
+		},
+		{
+			"a faceted search request with a post filter",
+			Search().
+				Query(Term("brand", "gucci")).
+				Aggs(TermsAgg("colors", "color")).
+				PostFilter(Term("color", "red")),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"term": map[string]interface{}{
+						"brand": map[string]interface{}{
+							"value": "gucci",
+						},
+					},
+				},
+				"aggs": map[string]interface{}{
+					"colors": map[string]interface{}{
+						"terms": map[string]interface{}{
+							"field": "color",
+						},
+					},
+				},
+				"post_filter": map[string]interface{}{
+					"term": map[string]interface{}{
+						"color": map[string]interface{}{
+							"value": "red",
+						},
+					},
+				},
+			},
+		},
+		{
+			"a search request tracking all total hits",
+			Search().Query(MatchAll()).TrackTotalHits(true),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match_all": map[string]interface{}{},
+				},
+				"track_total_hits": true,
+			},
+		},
+		{
+			"a search request not tracking total hits",
+			Search().TrackTotalHits(false),
+			map[string]interface{}{
+				"track_total_hits": false,
+			},
+		},
+		{
+			"a search request tracking total hits up to a limit",
+			Search().TrackTotalHits(100000),
+			map[string]interface{}{
+				"track_total_hits": 100000,
+			},
+		},
+		{
+			"a search request with a minimum score",
+			Search().Query(Match("title", "go")).MinScore(0.5),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match": map[string]interface{}{
+						"title": map[string]interface{}{
+							"query": "go",
+						},
+					},
+				},
+				"min_score": 0.5,
+			},
+		},
 	})
 }
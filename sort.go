@@ -0,0 +1,178 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+const (
+	// SortScore is the special field name for sorting by score.
+	SortScore = "_score"
+
+	// SortDoc is the special field name for sorting by index order.
+	SortDoc = "_doc"
+)
+
+// SortOption represents a sort criterion on a single field, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/sort-search-results.html
+// SortOption values can be provided to the SortBy methods of SearchRequest and
+// other types that support sorting.
+type SortOption struct {
+	field  string
+	params sortParams
+}
+
+type sortParams struct {
+	Order        Order       `structs:"order,omitempty"`
+	Mode         SortMode    `structs:"mode,string,omitempty"`
+	Missing      interface{} `structs:"missing,omitempty"`
+	UnmappedType string      `structs:"unmapped_type,omitempty"`
+}
+
+// SortBy creates a new sort criterion on the provided field. Use SortScore and
+// SortDoc to sort by score or by index order, respectively.
+func SortBy(field string) *SortOption {
+	return &SortOption{
+		field: field,
+	}
+}
+
+// Order sets the sort order.
+func (s *SortOption) Order(order Order) *SortOption {
+	s.params.Order = order
+	return s
+}
+
+// Mode sets which value to pick for sorting when the field has multiple
+// values.
+func (s *SortOption) Mode(mode SortMode) *SortOption {
+	s.params.Mode = mode
+	return s
+}
+
+// Missing sets how documents missing the field are sorted. It can be "_last",
+// "_first", or a custom value to use for such documents.
+func (s *SortOption) Missing(val interface{}) *SortOption {
+	s.params.Missing = val
+	return s
+}
+
+// UnmappedType sets the type to use for the field in indices where it isn't
+// mapped, rather than failing the request.
+func (s *SortOption) UnmappedType(t string) *SortOption {
+	s.params.UnmappedType = t
+	return s
+}
+
+// Map returns a map representation of the sort criterion, thus implementing
+// the Mappable interface.
+func (s *SortOption) Map() map[string]interface{} {
+	return map[string]interface{}{
+		s.field: structs.Map(s.params),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// GeoDistanceSortOption represents a sort criterion by distance from a
+// geographical point, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/sort-search-results.html#geo-sorting
+type GeoDistanceSortOption struct {
+	field  string
+	point  GeoPoint
+	params geoDistanceSortParams
+}
+
+type geoDistanceSortParams struct {
+	Order        Order        `structs:"order,omitempty"`
+	Unit         string       `structs:"unit,omitempty"`
+	DistanceType DistanceType `structs:"distance_type,string,omitempty"`
+	Mode         SortMode     `structs:"mode,string,omitempty"`
+}
+
+// GeoDistanceSort creates a new sort criterion by the distance between the
+// provided geo-point field and point.
+func GeoDistanceSort(field string, lat, lon float64) *GeoDistanceSortOption {
+	return &GeoDistanceSortOption{
+		field: field,
+		point: GeoPoint{Lat: lat, Lon: lon},
+	}
+}
+
+// Order sets the sort order.
+func (s *GeoDistanceSortOption) Order(order Order) *GeoDistanceSortOption {
+	s.params.Order = order
+	return s
+}
+
+// Unit sets the unit used to compute sort values (e.g. "km", "mi"). Defaults to
+// meters.
+func (s *GeoDistanceSortOption) Unit(unit string) *GeoDistanceSortOption {
+	s.params.Unit = unit
+	return s
+}
+
+// DistanceType sets how the distance is computed.
+func (s *GeoDistanceSortOption) DistanceType(t DistanceType) *GeoDistanceSortOption {
+	s.params.DistanceType = t
+	return s
+}
+
+// Mode sets which distance to pick for sorting when the field has multiple
+// geo points.
+func (s *GeoDistanceSortOption) Mode(mode SortMode) *GeoDistanceSortOption {
+	s.params.Mode = mode
+	return s
+}
+
+// Map returns a map representation of the sort criterion, thus implementing
+// the Mappable interface.
+func (s *GeoDistanceSortOption) Map() map[string]interface{} {
+	innerMap := structs.Map(s.params)
+	innerMap[s.field] = s.point.Map()
+
+	return map[string]interface{}{
+		"_geo_distance": innerMap,
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// SortMode is an enumeration type representing supported values for a sort
+// criterion's "mode" parameter.
+type SortMode uint8
+
+const (
+	_ SortMode = iota
+
+	// SortModeMin is the "min" mode
+	SortModeMin
+
+	// SortModeMax is the "max" mode
+	SortModeMax
+
+	// SortModeSum is the "sum" mode
+	SortModeSum
+
+	// SortModeAvg is the "avg" mode
+	SortModeAvg
+
+	// SortModeMedian is the "median" mode
+	SortModeMedian
+)
+
+// String returns a string representation of the sort mode, as known to
+// ElasticSearch.
+func (a SortMode) String() string {
+	switch a {
+	case SortModeMin:
+		return "min"
+	case SortModeMax:
+		return "max"
+	case SortModeSum:
+		return "sum"
+	case SortModeAvg:
+		return "avg"
+	case SortModeMedian:
+		return "median"
+	default:
+		return ""
+	}
+}
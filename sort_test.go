@@ -0,0 +1,73 @@
+package elasticsearch
+
+import "testing"
+
+func TestSort(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"simple field sort",
+			SortBy("date"),
+			map[string]interface{}{
+				"date": map[string]interface{}{},
+			},
+		},
+		{
+			"field sort with all options",
+			SortBy("price").
+				Order(OrderAsc).
+				Mode(SortModeAvg).
+				Missing("_last").
+				UnmappedType("long"),
+			map[string]interface{}{
+				"price": map[string]interface{}{
+					"order":         "asc",
+					"mode":          "avg",
+					"missing":       "_last",
+					"unmapped_type": "long",
+				},
+			},
+		},
+		{
+			"geo distance sort",
+			GeoDistanceSort("pin.location", 40, -70).
+				Order(OrderAsc).
+				Unit("km").
+				DistanceType(DistanceTypePlane).
+				Mode(SortModeMin),
+			map[string]interface{}{
+				"_geo_distance": map[string]interface{}{
+					"pin.location": map[string]interface{}{
+						"lat": 40,
+						"lon": -70,
+					},
+					"order":         "asc",
+					"unit":          "km",
+					"distance_type": "plane",
+					"mode":          "min",
+				},
+			},
+		},
+		{
+			"search request sorted by multiple criteria",
+			Search().
+				Sort("post_date", OrderAsc).
+				SortBy(
+					SortBy("price").Order(OrderDesc).Mode(SortModeMedian),
+					SortBy(SortScore).Order(OrderDesc),
+					SortBy(SortDoc),
+					GeoDistanceSort("location", 1.5, 2.5),
+				),
+			map[string]interface{}{
+				"sort": []map[string]interface{}{
+					{"post_date": map[string]interface{}{"order": "asc"}},
+					{"price": map[string]interface{}{"order": "desc", "mode": "median"}},
+					{"_score": map[string]interface{}{"order": "desc"}},
+					{"_doc": map[string]interface{}{}},
+					{"_geo_distance": map[string]interface{}{
+						"location": map[string]interface{}{"lat": 1.5, "lon": 2.5},
+					}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// PutScriptRequest represents a request to store a script in the cluster state
+// via ElasticSearch's Stored Script API, as described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/modules-scripting-using.html#script-stored-scripts
+// Stored scripts can then be referenced from queries and aggregations via
+// ScriptID.
+type PutScriptRequest struct {
+	id     string
+	script *Script
+}
+
+// PutScript creates a new request storing the provided script under the
+// provided ID. Only inline scripts (created via ScriptSource) can be stored,
+// and only their source and language are (the language defaults to
+// "painless"). Parameters are not stored: they are provided by the scripts
+// referencing the stored script via ScriptID.
+func PutScript(id string, script *Script) *PutScriptRequest {
+	return &PutScriptRequest{
+		id:     id,
+		script: script,
+	}
+}
+
+// Map returns a map representation of the request's body, thus implementing
+// the Mappable interface.
+func (req *PutScriptRequest) Map() map[string]interface{} {
+	if req.script == nil {
+		return map[string]interface{}{}
+	}
+
+	lang := req.script.lang
+	if lang == "" {
+		lang = "painless"
+	}
+
+	return map[string]interface{}{
+		"script": map[string]interface{}{
+			"lang":   lang,
+			"source": req.script.source,
+		},
+	}
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more put script options can be provided as well. It returns the standard
+// Response type of the official Go client.
+func (req *PutScriptRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.PutScriptRequest),
+) (res *esapi.Response, err error) {
+	return req.RunPutScript(api.PutScript, o...)
+}
+
+// RunPutScript is the same as the Run method, except that it accepts a value
+// of type esapi.PutScript (usually this is the PutScript field of an
+// elasticsearch.Client object), which allows using mock clients. It returns an
+// error without executing the request if the script has no source.
+func (req *PutScriptRequest) RunPutScript(
+	put esapi.PutScript,
+	o ...func(*esapi.PutScriptRequest),
+) (res *esapi.Response, err error) {
+	if req.script == nil || req.script.source == "" {
+		return nil, errors.New("stored scripts require an inline script source")
+	}
+
+	var b bytes.Buffer
+	err = json.NewEncoder(&b).Encode(req.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	return put(req.id, &b, o...)
+}
+
+//----------------------------------------------------------------------------//
+
+// GetScriptRequest represents a request to retrieve a stored script.
+type GetScriptRequest struct {
+	id string
+}
+
+// StoredScriptResult is the decoded response of a get script request.
+type StoredScriptResult struct {
+	ID     string `json:"_id"`
+	Found  bool   `json:"found"`
+	Script struct {
+		Lang   string `json:"lang"`
+		Source string `json:"source"`
+	} `json:"script"`
+}
+
+// GetScript creates a new request retrieving the stored script with the
+// provided ID.
+func GetScript(id string) *GetScriptRequest {
+	return &GetScriptRequest{id: id}
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more get script options can be provided as well. It returns the standard
+// Response type of the official Go client.
+func (req *GetScriptRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.GetScriptRequest),
+) (res *esapi.Response, err error) {
+	return req.RunGetScript(api.GetScript, o...)
+}
+
+// RunGetScript is the same as the Run method, except that it accepts a value
+// of type esapi.GetScript (usually this is the GetScript field of an
+// elasticsearch.Client object), which allows using mock clients.
+func (req *GetScriptRequest) RunGetScript(
+	get esapi.GetScript,
+	o ...func(*esapi.GetScriptRequest),
+) (res *esapi.Response, err error) {
+	return get(req.id, o...)
+}
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the decoded result. The response body is closed by Do. Unsuccessful
+// responses, including missing scripts, are returned as an *ESError.
+func (req *GetScriptRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.GetScriptRequest),
+) (*StoredScriptResult, error) {
+	return req.DoGetScript(api.GetScript, o...)
+}
+
+// DoGetScript is the same as the Do method, except that it accepts a value of
+// type esapi.GetScript, just like the RunGetScript method.
+func (req *GetScriptRequest) DoGetScript(
+	get esapi.GetScript,
+	o ...func(*esapi.GetScriptRequest),
+) (*StoredScriptResult, error) {
+	res, err := CheckResponse(req.RunGetScript(get, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var result StoredScriptResult
+	err = json.NewDecoder(res.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding get script response: %w", err)
+	}
+
+	return &result, nil
+}
+
+//----------------------------------------------------------------------------//
+
+// DeleteScriptRequest represents a request to delete a stored script.
+type DeleteScriptRequest struct {
+	id string
+}
+
+// DeleteScript creates a new request deleting the stored script with the
+// provided ID.
+func DeleteScript(id string) *DeleteScriptRequest {
+	return &DeleteScriptRequest{id: id}
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more delete script options can be provided as well. It returns the standard
+// Response type of the official Go client.
+func (req *DeleteScriptRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.DeleteScriptRequest),
+) (res *esapi.Response, err error) {
+	return req.RunDeleteScript(api.DeleteScript, o...)
+}
+
+// RunDeleteScript is the same as the Run method, except that it accepts a
+// value of type esapi.DeleteScript (usually this is the DeleteScript field of
+// an elasticsearch.Client object), which allows using mock clients.
+func (req *DeleteScriptRequest) RunDeleteScript(
+	del esapi.DeleteScript,
+	o ...func(*esapi.DeleteScriptRequest),
+) (res *esapi.Response, err error) {
+	return del(req.id, o...)
+}
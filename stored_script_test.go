@@ -0,0 +1,99 @@
+package elasticsearch
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestPutScript(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"put script with default language",
+			PutScript("score-by-likes", ScriptSource("doc['likes'].value * params.factor")),
+			map[string]interface{}{
+				"script": map[string]interface{}{
+					"lang":   "painless",
+					"source": "doc['likes'].value * params.factor",
+				},
+			},
+		},
+		{
+			"put script with explicit language",
+			PutScript("tpl", ScriptSource(`{"query": {"match_all": {}}}`).Lang("mustache")),
+			map[string]interface{}{
+				"script": map[string]interface{}{
+					"lang":   "mustache",
+					"source": `{"query": {"match_all": {}}}`,
+				},
+			},
+		},
+	})
+}
+
+func TestPutScriptRun(t *testing.T) {
+	var reqID, reqBody string
+	put := esapi.PutScript(func(id string, body io.Reader, o ...func(*esapi.PutScriptRequest)) (*esapi.Response, error) {
+		b, _ := ioutil.ReadAll(body)
+		reqID, reqBody = id, string(b)
+
+		return fakeResponse(200, `{"acknowledged":true}`), nil
+	})
+
+	_, err := CheckResponse(PutScript("my-script", ScriptSource("1")).RunPutScript(put))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-script", reqID)
+	assert.Equal(t, `{"script":{"lang":"painless","source":"1"}}`+"\n", reqBody)
+}
+
+func TestPutScriptRunWithoutSource(t *testing.T) {
+	var called bool
+	put := esapi.PutScript(func(id string, body io.Reader, o ...func(*esapi.PutScriptRequest)) (*esapi.Response, error) {
+		called = true
+		return nil, nil
+	})
+
+	_, err := PutScript("my-script", nil).RunPutScript(put)
+	assert.NotNil(t, err)
+	_, err = PutScript("my-script", ScriptID("other-script")).RunPutScript(put)
+	assert.NotNil(t, err)
+	assert.False(t, called)
+}
+
+func TestDeleteScriptRun(t *testing.T) {
+	var reqID string
+	del := esapi.DeleteScript(func(id string, o ...func(*esapi.DeleteScriptRequest)) (*esapi.Response, error) {
+		reqID = id
+
+		return fakeResponse(404,
+			`{"error":{"type":"resource_not_found_exception","reason":"stored script [my-script] does not exist"},"status":404}`,
+		), nil
+	})
+
+	_, err := CheckResponse(DeleteScript("my-script").RunDeleteScript(del))
+	assert.Equal(t, "my-script", reqID)
+	esErr, ok := err.(*ESError)
+	assert.True(t, ok)
+	assert.Equal(t, "resource_not_found_exception", esErr.Type)
+}
+
+func TestGetScriptDo(t *testing.T) {
+	var reqID string
+	get := esapi.GetScript(func(id string, o ...func(*esapi.GetScriptRequest)) (*esapi.Response, error) {
+		reqID = id
+
+		return fakeResponse(200,
+			`{"_id":"my-script","found":true,"script":{"lang":"painless","source":"doc['likes'].value"}}`,
+		), nil
+	})
+
+	res, err := GetScript("my-script").DoGetScript(get)
+	assert.Nil(t, err)
+	assert.Equal(t, "my-script", reqID)
+	assert.True(t, res.Found)
+	assert.Equal(t, "painless", res.Script.Lang)
+	assert.Equal(t, "doc['likes'].value", res.Script.Source)
+}
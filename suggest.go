@@ -0,0 +1,223 @@
+package elasticsearch
+
+import "github.com/fatih/structs"
+
+// Suggester is an interface that each suggester type must implement. Like the
+// Aggregation interface, it extends the Mappable interface with a Name
+// function, which returns the name of the suggestion in the request and in
+// the response.
+type Suggester interface {
+	Mappable
+	Name() string
+}
+
+// SuggestMode is an enumeration type representing the supported values for a
+// suggester's "suggest_mode" option.
+type SuggestMode uint8
+
+const (
+	_ SuggestMode = iota
+
+	// SuggestModeMissing is the "missing" suggest mode
+	SuggestModeMissing
+
+	// SuggestModePopular is the "popular" suggest mode
+	SuggestModePopular
+
+	// SuggestModeAlways is the "always" suggest mode
+	SuggestModeAlways
+)
+
+// String returns a string representation of the suggest mode, as known to
+// ElasticSearch.
+func (a SuggestMode) String() string {
+	switch a {
+	case SuggestModeMissing:
+		return "missing"
+	case SuggestModePopular:
+		return "popular"
+	case SuggestModeAlways:
+		return "always"
+	default:
+		return ""
+	}
+}
+
+// TextSuggester represents a suggester of type "term" or "phrase", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-suggesters.html
+// While both share the same general structure, they don't support all the
+// same options. The library does not attempt to verify provided options are
+// supported.
+type TextSuggester struct {
+	name   string
+	kind   string
+	text   string
+	params textSuggesterParams
+}
+
+type textSuggesterParams struct {
+	Field       string      `structs:"field"`
+	Size        uint16      `structs:"size,omitempty"`
+	SuggestMode SuggestMode `structs:"suggest_mode,string,omitempty"`
+	MaxErrors   float32     `structs:"max_errors,omitempty"` // only relevant for phrase suggesters
+	GramSize    uint8       `structs:"gram_size,omitempty"`  // only relevant for phrase suggesters
+}
+
+// Suggest creates a new suggester with the provided name, which is of type
+// "term" unless Phrase is called. The suggester is added to a search request
+// via its Suggest method.
+func Suggest(name string) *TextSuggester {
+	return &TextSuggester{
+		name: name,
+		kind: "term",
+	}
+}
+
+// Name returns the name of the suggester.
+func (s *TextSuggester) Name() string {
+	return s.name
+}
+
+// Term makes the suggester a "term" suggester, suggesting terms for the
+// provided text based on the provided field.
+func (s *TextSuggester) Term(field, text string) *TextSuggester {
+	s.kind = "term"
+	s.params.Field = field
+	s.text = text
+	return s
+}
+
+// Phrase makes the suggester a "phrase" suggester, suggesting entire
+// corrected phrases for the provided text based on the provided field.
+func (s *TextSuggester) Phrase(field, text string) *TextSuggester {
+	s.kind = "phrase"
+	s.params.Field = field
+	s.text = text
+	return s
+}
+
+// Size sets the maximum number of suggestions to return for each token (term
+// suggesters) or for the entire text (phrase suggesters).
+func (s *TextSuggester) Size(size uint16) *TextSuggester {
+	s.params.Size = size
+	return s
+}
+
+// SuggestMode sets which suggestions are included in the response.
+func (s *TextSuggester) SuggestMode(mode SuggestMode) *TextSuggester {
+	s.params.SuggestMode = mode
+	return s
+}
+
+// MaxErrors sets the maximum percentage (if below 1) or number of terms that
+// are considered misspellings. Only relevant for phrase suggesters.
+func (s *TextSuggester) MaxErrors(n float32) *TextSuggester {
+	s.params.MaxErrors = n
+	return s
+}
+
+// GramSize sets the maximum size of the n-grams in the field. Only relevant
+// for phrase suggesters.
+func (s *TextSuggester) GramSize(n uint8) *TextSuggester {
+	s.params.GramSize = n
+	return s
+}
+
+// Map returns a map representation of the suggester, thus implementing the
+// Mappable interface.
+func (s *TextSuggester) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"text": s.text,
+		s.kind: structs.Map(s.params),
+	}
+}
+
+//----------------------------------------------------------------------------//
+
+// CompletionSuggester represents a suggester of type "completion", which
+// provides search-as-you-type functionality on fields of type "completion", as
+// described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-suggesters.html#completion-suggester
+type CompletionSuggester struct {
+	name   string
+	prefix string
+	params completionSuggesterParams
+}
+
+type completionSuggesterParams struct {
+	Field          string        `structs:"field"`
+	Size           uint16        `structs:"size,omitempty"`
+	SkipDuplicates *bool         `structs:"skip_duplicates,omitempty"`
+	Fuzzy          *FuzzyOptions `structs:"fuzzy,omitempty"`
+}
+
+// FuzzyOptions represents the fuzzy matching options of a completion
+// suggester. Unset fields are omitted, leaving ElasticSearch's defaults in
+// place.
+type FuzzyOptions struct {
+	// Fuzziness is the maximum edit distance, e.g. "AUTO" or 1
+	Fuzziness interface{} `structs:"fuzziness,omitempty"`
+
+	// Transpositions denotes whether transpositions count as one change
+	// rather than two
+	Transpositions *bool `structs:"transpositions,omitempty"`
+
+	// MinLength is the minimum length of the input before fuzzy suggestions
+	// are returned
+	MinLength uint16 `structs:"min_length,omitempty"`
+
+	// PrefixLength is the length of the input prefix not checked for fuzzy
+	// alternatives
+	PrefixLength uint16 `structs:"prefix_length,omitempty"`
+
+	// UnicodeAware denotes whether measurements are in Unicode code points
+	// rather than bytes
+	UnicodeAware bool `structs:"unicode_aware,omitempty"`
+}
+
+// CompletionSuggest creates a new suggester of type "completion" with the
+// provided name, suggesting completions of the provided prefix from the
+// provided field.
+func CompletionSuggest(name, field, prefix string) *CompletionSuggester {
+	return &CompletionSuggester{
+		name:   name,
+		prefix: prefix,
+		params: completionSuggesterParams{
+			Field: field,
+		},
+	}
+}
+
+// Name returns the name of the suggester.
+func (s *CompletionSuggester) Name() string {
+	return s.name
+}
+
+// Size sets the maximum number of suggestions to return.
+func (s *CompletionSuggester) Size(size uint16) *CompletionSuggester {
+	s.params.Size = size
+	return s
+}
+
+// SkipDuplicates sets whether suggestions with duplicate text are filtered
+// out.
+func (s *CompletionSuggester) SkipDuplicates(b bool) *CompletionSuggester {
+	s.params.SkipDuplicates = &b
+	return s
+}
+
+// Fuzzy enables fuzzy matching of the prefix, with the provided options.
+func (s *CompletionSuggester) Fuzzy(opts FuzzyOptions) *CompletionSuggester {
+	s.params.Fuzzy = &opts
+	return s
+}
+
+// Map returns a map representation of the suggester, thus implementing the
+// Mappable interface.
+func (s *CompletionSuggester) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"prefix":     s.prefix,
+		"completion": structs.Map(s.params),
+	}
+}
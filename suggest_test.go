@@ -0,0 +1,97 @@
+package elasticsearch
+
+import (
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"term suggester",
+			Suggest("my-suggestion").
+				Term("message", "tring out Elasticsearch").
+				Size(3).
+				SuggestMode(SuggestModePopular),
+			map[string]interface{}{
+				"text": "tring out Elasticsearch",
+				"term": map[string]interface{}{
+					"field":        "message",
+					"size":         3,
+					"suggest_mode": "popular",
+				},
+			},
+		},
+		{
+			"term and phrase suggesters in a search request",
+			Search().
+				Query(Match("message", "tring out Elasticsearch")).
+				Suggest(
+					Suggest("terms").Term("message", "tring out"),
+					Suggest("phrases").
+						Phrase("title.trigram", "noble prize").
+						MaxErrors(0.5).
+						GramSize(3),
+				),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"match": map[string]interface{}{
+						"message": map[string]interface{}{
+							"query": "tring out Elasticsearch",
+						},
+					},
+				},
+				"suggest": map[string]interface{}{
+					"terms": map[string]interface{}{
+						"text": "tring out",
+						"term": map[string]interface{}{
+							"field": "message",
+						},
+					},
+					"phrases": map[string]interface{}{
+						"text": "noble prize",
+						"phrase": map[string]interface{}{
+							"field":      "title.trigram",
+							"max_errors": 0.5,
+							"gram_size":  3,
+						},
+					},
+				},
+			},
+		},
+		{
+			"completion suggester",
+			Search().Suggest(
+				CompletionSuggest("song-suggest", "suggest", "nir").
+					Size(5).
+					SkipDuplicates(true).
+					Fuzzy(FuzzyOptions{Fuzziness: 2, MinLength: 3}),
+			),
+			map[string]interface{}{
+				"suggest": map[string]interface{}{
+					"song-suggest": map[string]interface{}{
+						"prefix": "nir",
+						"completion": map[string]interface{}{
+							"field":           "suggest",
+							"size":            5,
+							"skip_duplicates": true,
+							"fuzzy": map[string]interface{}{
+								"fuzziness":  2,
+								"min_length": 3,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"completion suggester without options",
+			CompletionSuggest("song-suggest", "suggest", "nir"),
+			map[string]interface{}{
+				"prefix": "nir",
+				"completion": map[string]interface{}{
+					"field": "suggest",
+				},
+			},
+		},
+	})
+}
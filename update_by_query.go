@@ -0,0 +1,166 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// UpdateByQueryRequest represents a request to ElasticSearch's Update By Query
+// API, described in
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-update-by-query.html
+type UpdateByQueryRequest struct {
+	index             []string
+	query             Mappable
+	script            *Script
+	conflicts         string
+	refresh           *bool
+	waitForCompletion *bool
+}
+
+// ByQueryResult is the decoded response of a by-query request, such as update
+// by query, delete by query and reindex. If the request was executed without
+// waiting for completion, only Task is set.
+type ByQueryResult struct {
+	Took             int64             `json:"took"`
+	TimedOut         bool              `json:"timed_out"`
+	Total            int64             `json:"total"`
+	Created          int64             `json:"created"`
+	Updated          int64             `json:"updated"`
+	Deleted          int64             `json:"deleted"`
+	Batches          int64             `json:"batches"`
+	VersionConflicts int64             `json:"version_conflicts"`
+	Noops            int64             `json:"noops"`
+	Failures         []json.RawMessage `json:"failures"`
+	Task             string            `json:"task"`
+}
+
+// UpdateByQuery creates a new UpdateByQueryRequest object for the provided
+// query, to be filled via method chaining.
+func UpdateByQuery(q Mappable) *UpdateByQueryRequest {
+	return &UpdateByQueryRequest{
+		query: q,
+	}
+}
+
+// Index sets the index names for the request.
+func (req *UpdateByQueryRequest) Index(index ...string) *UpdateByQueryRequest {
+	req.index = index
+	return req
+}
+
+// Script sets the script used to update the matching documents. Without a
+// script, the documents are reindexed as-is, which is useful to pick up mapping
+// changes.
+func (req *UpdateByQueryRequest) Script(script *Script) *UpdateByQueryRequest {
+	req.script = script
+	return req
+}
+
+// Conflicts sets what to do when version conflicts occur: "abort" (the
+// default) or "proceed".
+func (req *UpdateByQueryRequest) Conflicts(c string) *UpdateByQueryRequest {
+	req.conflicts = c
+	return req
+}
+
+// Refresh sets whether the affected indices are refreshed once the request
+// completes.
+func (req *UpdateByQueryRequest) Refresh(b bool) *UpdateByQueryRequest {
+	req.refresh = &b
+	return req
+}
+
+// WaitForCompletion sets whether the request blocks until the operation
+// completes. If false, ElasticSearch starts a task and returns its ID instead.
+func (req *UpdateByQueryRequest) WaitForCompletion(b bool) *UpdateByQueryRequest {
+	req.waitForCompletion = &b
+	return req
+}
+
+// Map returns a map representation of the request's body, thus implementing
+// the Mappable interface.
+func (req *UpdateByQueryRequest) Map() map[string]interface{} {
+	m := make(map[string]interface{})
+	if req.query != nil {
+		m["query"] = req.query.Map()
+	}
+	if req.script != nil {
+		m["script"] = req.script.Map()
+	}
+
+	return m
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more update by query options can be provided as well; they take precedence
+// over the options set on the request. It returns the standard Response type of
+// the official Go client.
+func (req *UpdateByQueryRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.UpdateByQueryRequest),
+) (res *esapi.Response, err error) {
+	return req.RunUpdateByQuery(api.UpdateByQuery, o...)
+}
+
+// RunUpdateByQuery is the same as the Run method, except that it accepts a
+// value of type esapi.UpdateByQuery (usually this is the UpdateByQuery field of
+// an elasticsearch.Client object), which allows using mock clients.
+func (req *UpdateByQueryRequest) RunUpdateByQuery(
+	update esapi.UpdateByQuery,
+	o ...func(*esapi.UpdateByQueryRequest),
+) (res *esapi.Response, err error) {
+	var b bytes.Buffer
+	err = json.NewEncoder(&b).Encode(req.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []func(*esapi.UpdateByQueryRequest){update.WithBody(&b)}
+	if req.conflicts != "" {
+		opts = append(opts, update.WithConflicts(req.conflicts))
+	}
+	if req.refresh != nil {
+		opts = append(opts, update.WithRefresh(*req.refresh))
+	}
+	if req.waitForCompletion != nil {
+		opts = append(opts, update.WithWaitForCompletion(*req.waitForCompletion))
+	}
+
+	return update(req.index, append(opts, o...)...)
+}
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the decoded result. The response body is closed by Do. Unsuccessful
+// responses are returned as an *ESError.
+func (req *UpdateByQueryRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.UpdateByQueryRequest),
+) (*ByQueryResult, error) {
+	return req.DoUpdateByQuery(api.UpdateByQuery, o...)
+}
+
+// DoUpdateByQuery is the same as the Do method, except that it accepts a value
+// of type esapi.UpdateByQuery, just like the RunUpdateByQuery method.
+func (req *UpdateByQueryRequest) DoUpdateByQuery(
+	update esapi.UpdateByQuery,
+	o ...func(*esapi.UpdateByQueryRequest),
+) (*ByQueryResult, error) {
+	res, err := CheckResponse(req.RunUpdateByQuery(update, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var result ByQueryResult
+	err = json.NewDecoder(res.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding update by query response: %w", err)
+	}
+
+	return &result, nil
+}
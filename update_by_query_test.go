@@ -0,0 +1,73 @@
+package elasticsearch
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func TestUpdateByQuery(t *testing.T) {
+	runMapTests(t, []mapTest{
+		{
+			"update by query with a script",
+			UpdateByQuery(Term("status", "expired")).
+				Script(ScriptSource("ctx._source.status = params.status").Params(map[string]interface{}{
+					"status": "archived",
+				})),
+			map[string]interface{}{
+				"query": map[string]interface{}{
+					"term": map[string]interface{}{
+						"status": map[string]interface{}{
+							"value": "expired",
+						},
+					},
+				},
+				"script": map[string]interface{}{
+					"source": "ctx._source.status = params.status",
+					"params": map[string]interface{}{
+						"status": "archived",
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestUpdateByQueryDo(t *testing.T) {
+	var reqIndex []string
+	var reqBody string
+	var req esapi.UpdateByQueryRequest
+	update := esapi.UpdateByQuery(func(index []string, o ...func(*esapi.UpdateByQueryRequest)) (*esapi.Response, error) {
+		for _, f := range o {
+			f(&req)
+		}
+		b, _ := ioutil.ReadAll(req.Body)
+		reqIndex, reqBody = index, string(b)
+
+		return fakeResponse(200,
+			`{"took":12,"timed_out":false,"total":3,"updated":2,"version_conflicts":1,"failures":[]}`,
+		), nil
+	})
+
+	res, err := UpdateByQuery(Term("status", "expired")).
+		Index("posts").
+		Script(ScriptSource("ctx._source.status = 'archived'")).
+		Conflicts("proceed").
+		Refresh(true).
+		WaitForCompletion(true).
+		DoUpdateByQuery(update)
+	assert.Nil(t, err)
+	assert.DeepEqual(t, []string{"posts"}, reqIndex)
+	assert.Equal(
+		t,
+		`{"query":{"term":{"status":{"value":"expired"}}},"script":{"source":"ctx._source.status = 'archived'"}}`+"\n",
+		reqBody,
+	)
+	assert.Equal(t, "proceed", req.Conflicts)
+	assert.True(t, req.Refresh != nil && *req.Refresh)
+	assert.True(t, req.WaitForCompletion != nil && *req.WaitForCompletion)
+	assert.Equal(t, int64(2), res.Updated)
+	assert.Equal(t, int64(1), res.VersionConflicts)
+}
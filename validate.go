@@ -0,0 +1,126 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+)
+
+// ValidateRequest represents a request to ElasticSearch's Validate API, which
+// checks whether a query is valid without executing it, as described in:
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-validate.html
+type ValidateRequest struct {
+	query   Mappable
+	explain bool
+}
+
+// ValidateResult is the decoded response of a validate request.
+type ValidateResult struct {
+	// Valid denotes whether the query is valid.
+	Valid bool `json:"valid"`
+
+	// Explanations contains an explanation of the query (or of why it is
+	// invalid) per index, if the request was executed with the explain flag.
+	Explanations []ValidateExplanation `json:"explanations"`
+}
+
+// ValidateExplanation is the explanation of a validated query on one index.
+type ValidateExplanation struct {
+	Index       string `json:"index"`
+	Shard       int    `json:"shard"`
+	Valid       bool   `json:"valid"`
+	Explanation string `json:"explanation"`
+	Error       string `json:"error"`
+}
+
+// Validate creates a new validate request for the provided query. The request
+// asks ElasticSearch to explain the query by default, see Explain.
+func Validate(q Mappable) *ValidateRequest {
+	return &ValidateRequest{
+		query:   q,
+		explain: true,
+	}
+}
+
+// Explain sets whether ElasticSearch should return an explanation of the query,
+// which includes the reason it is invalid. It is enabled by default.
+func (req *ValidateRequest) Explain(b bool) *ValidateRequest {
+	req.explain = b
+	return req
+}
+
+// Map returns a map representation of the request, thus implementing the
+// Mappable interface.
+func (req *ValidateRequest) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"query": req.query.Map(),
+	}
+}
+
+// Run executes the request using the provided ElasticSearch client. Zero or
+// more validate options can be provided as well (e.g. the indices to validate
+// the query against). It returns the standard Response type of the official Go
+// client.
+func (req *ValidateRequest) Run(
+	api *elasticsearch.Client,
+	o ...func(*esapi.IndicesValidateQueryRequest),
+) (res *esapi.Response, err error) {
+	return req.RunValidate(api.Indices.ValidateQuery, o...)
+}
+
+// RunValidate is the same as the Run method, except that it accepts a value of
+// type esapi.IndicesValidateQuery (usually this is the Indices.ValidateQuery
+// field of an elasticsearch.Client object), which allows using mock clients.
+func (req *ValidateRequest) RunValidate(
+	validate esapi.IndicesValidateQuery,
+	o ...func(*esapi.IndicesValidateQueryRequest),
+) (res *esapi.Response, err error) {
+	var b bytes.Buffer
+	err = json.NewEncoder(&b).Encode(req.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	opts := append([]func(*esapi.IndicesValidateQueryRequest){
+		validate.WithBody(&b),
+		validate.WithExplain(req.explain),
+	}, o...)
+
+	return validate(opts...)
+}
+
+// Do executes the request using the provided ElasticSearch client, and returns
+// the decoded result. The response body is closed by Do. Unsuccessful
+// responses are returned as an *ESError; note that an invalid query is not an
+// unsuccessful response, but a result whose Valid field is false.
+func (req *ValidateRequest) Do(
+	api *elasticsearch.Client,
+	o ...func(*esapi.IndicesValidateQueryRequest),
+) (*ValidateResult, error) {
+	return req.DoValidate(api.Indices.ValidateQuery, o...)
+}
+
+// DoValidate is the same as the Do method, except that it accepts a value of
+// type esapi.IndicesValidateQuery, just like the RunValidate method.
+func (req *ValidateRequest) DoValidate(
+	validate esapi.IndicesValidateQuery,
+	o ...func(*esapi.IndicesValidateQueryRequest),
+) (*ValidateResult, error) {
+	res, err := CheckResponse(req.RunValidate(validate, o...))
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	var result ValidateResult
+	err = json.NewDecoder(res.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding validate response: %w", err)
+	}
+
+	return &result, nil
+}
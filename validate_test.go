@@ -0,0 +1,60 @@
+package elasticsearch
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/jgroeneveld/trial/assert"
+)
+
+func fakeValidate(
+	resBody string,
+	reqBody *string,
+	explain **bool,
+) esapi.IndicesValidateQuery {
+	return func(o ...func(*esapi.IndicesValidateQueryRequest)) (*esapi.Response, error) {
+		var req esapi.IndicesValidateQueryRequest
+		for _, f := range o {
+			f(&req)
+		}
+		b, _ := ioutil.ReadAll(req.Body)
+		*reqBody = string(b)
+		*explain = req.Explain
+
+		return fakeResponse(200, resBody), nil
+	}
+}
+
+func TestValidateDo(t *testing.T) {
+	var reqBody string
+	var explain *bool
+
+	t.Run("invalid query with explanation", func(t *testing.T) {
+		validate := fakeValidate(`{
+			"valid": false,
+			"explanations": [
+				{"index": "posts", "valid": false, "error": "failed to parse date field [foo]"}
+			]
+		}`, &reqBody, &explain)
+
+		res, err := Validate(Range("date").Gte("foo")).DoValidate(validate)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"query":{"range":{"date":{"gte":"foo"}}}}`+"\n", reqBody)
+		assert.True(t, explain != nil && *explain)
+		assert.False(t, res.Valid)
+		assert.Equal(t, 1, len(res.Explanations))
+		assert.Equal(t, "posts", res.Explanations[0].Index)
+		assert.Equal(t, "failed to parse date field [foo]", res.Explanations[0].Error)
+	})
+
+	t.Run("valid query without explanation", func(t *testing.T) {
+		validate := fakeValidate(`{"valid": true}`, &reqBody, &explain)
+
+		res, err := Validate(MatchAll()).Explain(false).DoValidate(validate)
+		assert.Nil(t, err)
+		assert.True(t, explain != nil && !*explain)
+		assert.True(t, res.Valid)
+		assert.Equal(t, 0, len(res.Explanations))
+	})
+}